@@ -0,0 +1,82 @@
+package weaviate
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/graphql"
+)
+
+// aggregateCount returns the number of objects of a collection, optionally
+// for a single tenant and matching a where filter
+func (c *Client) aggregateCount(className string, tenant string, where *filters.WhereBuilder) (int64, error) {
+	aggregate := c.client.GraphQL().Aggregate().
+		WithClassName(className).
+		WithFields(graphql.Field{Name: "meta", Fields: []graphql.Field{{Name: "count"}}})
+	if tenant != "" {
+		aggregate = aggregate.WithTenant(tenant)
+	}
+	if where != nil {
+		aggregate = aggregate.WithWhere(where)
+	}
+
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	response, err := aggregate.Do(ctx)
+	if err != nil {
+		return 0, requestError(ctx, err)
+	}
+	if len(response.Errors) > 0 {
+		return 0, graphQLError(response.Errors)
+	}
+
+	result, _ := response.Data["Aggregate"].(map[string]interface{})
+	groups, _ := result[graphQLClassName(className)].([]interface{})
+	if len(groups) == 0 {
+		return 0, fmt.Errorf("unexpected graphql response: missing Aggregate result for %s", className)
+	}
+	group, _ := groups[0].(map[string]interface{})
+	meta, _ := group["meta"].(map[string]interface{})
+	count, ok := ToFloat64(meta["count"])
+	if !ok {
+		return 0, fmt.Errorf("unexpected graphql response: missing meta count for %s", className)
+	}
+	return int64(count), nil
+}
+
+// GetObjectsCount returns the number of objects in a collection, or in a
+// single tenant when tenant is not empty
+func (c *Client) GetObjectsCount(className string, tenant string) (int64, error) {
+	if tenant == "" {
+		tenant = c.tenant
+	}
+	return c.aggregateCount(className, tenant, nil)
+}
+
+// TenantObjectCounts counts the objects of every given tenant, running up to
+// concurrency aggregate queries at once. The result holds the counts by
+// tenant name under counts, and the error of every tenant that could not be
+// counted under errors.
+func (c *Client) TenantObjectCounts(className string, tenants []string, concurrency int) (map[string]interface{}, error) {
+	counts := make([]int64, len(tenants))
+	errs := forEachConcurrently(len(tenants), concurrency, func(i int) error {
+		count, err := c.aggregateCount(className, tenants[i], nil)
+		counts[i] = count
+		return err
+	})
+
+	countsByTenant := make(map[string]int64, len(tenants))
+	errorsByTenant := make(map[string]string)
+	for i, tenant := range tenants {
+		if errs[i] != nil {
+			errorsByTenant[tenant] = errs[i].Error()
+			continue
+		}
+		countsByTenant[tenant] = counts[i]
+	}
+
+	return map[string]interface{}{
+		"counts": countsByTenant,
+		"errors": errorsByTenant,
+	}, nil
+}
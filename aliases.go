@@ -0,0 +1,47 @@
+package weaviate
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// alias mirrors the alias object of the Weaviate REST API
+type alias struct {
+	Alias string `json:"alias,omitempty"`
+	Class string `json:"class"`
+}
+
+// CreateAlias creates an alias pointing to a collection. Aliases can be used
+// in place of the collection name in every other operation.
+func (c *Client) CreateAlias(aliasName string, className string) error {
+	return c.runREST(http.MethodPost, "/aliases", alias{Alias: aliasName, Class: className}, nil, http.StatusOK)
+}
+
+// UpdateAlias points an existing alias to a different collection
+func (c *Client) UpdateAlias(aliasName string, newClassName string) error {
+	return c.runREST(http.MethodPut, "/aliases/"+url.PathEscape(aliasName), alias{Class: newClassName}, nil, http.StatusOK)
+}
+
+// DeleteAlias deletes an alias, the collection it points to is kept
+func (c *Client) DeleteAlias(aliasName string) error {
+	return c.runREST(http.MethodDelete, "/aliases/"+url.PathEscape(aliasName), nil, nil, http.StatusNoContent)
+}
+
+// ListAliases lists all aliases as {alias, class} maps
+func (c *Client) ListAliases() ([]map[string]interface{}, error) {
+	var response struct {
+		Aliases []alias `json:"aliases"`
+	}
+	if err := c.runREST(http.MethodGet, "/aliases", nil, &response, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	output := make([]map[string]interface{}, len(response.Aliases))
+	for i, a := range response.Aliases {
+		output[i] = map[string]interface{}{
+			"alias": a.Alias,
+			"class": a.Class,
+		}
+	}
+	return output, nil
+}
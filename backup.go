@@ -0,0 +1,215 @@
+package weaviate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Kinds of backup operations WaitForBackup can wait for
+const (
+	backupKindCreate  = "create"
+	backupKindRestore = "restore"
+)
+
+// terminalBackupStatuses are the statuses a backup operation does not leave,
+// STARTED, TRANSFERRING and TRANSFERRED are still in progress
+var terminalBackupStatuses = map[string]bool{
+	models.BackupCreateStatusResponseStatusSUCCESS:  true,
+	models.BackupCreateStatusResponseStatusFAILED:   true,
+	models.BackupCreateStatusResponseStatusCANCELED: true,
+}
+
+// BackupCreate starts a backup of the collections to a backup backend
+// (filesystem, s3, gcs or azure) and returns {id, backend, path, status,
+// classes, error}. options is an optional map of:
+// include and exclude, the collections to back up or to leave out (default all)
+// waitForCompletion to return only once the backup finished (default false)
+func (c *Client) BackupCreate(backend string, backupID string, options map[string]interface{}) (map[string]interface{}, error) {
+	creator := c.client.Backup().Creator().
+		WithBackend(backend).
+		WithBackupID(backupID).
+		WithWaitForCompletion(GetBoolValue(options, "waitForCompletion", false))
+	include, exclude, err := backupClassNames(options)
+	if err != nil {
+		return nil, err
+	}
+	if len(include) > 0 {
+		creator = creator.WithIncludeClassNames(include...)
+	}
+	if len(exclude) > 0 {
+		creator = creator.WithExcludeClassNames(exclude...)
+	}
+
+	ctx, cancel := c.requestContext(options)
+	defer cancel()
+	response, err := creator.Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	return backupResult(response.ID, response.Backend, response.Path, response.Status, response.Classes, response.Error), nil
+}
+
+// BackupRestore restores the collections of a backup, which must not exist
+// anymore, and returns {id, backend, path, status, classes, error}. options
+// takes the same include, exclude and waitForCompletion keys as BackupCreate.
+func (c *Client) BackupRestore(backend string, backupID string, options map[string]interface{}) (map[string]interface{}, error) {
+	restorer := c.client.Backup().Restorer().
+		WithBackend(backend).
+		WithBackupID(backupID).
+		WithWaitForCompletion(GetBoolValue(options, "waitForCompletion", false))
+	include, exclude, err := backupClassNames(options)
+	if err != nil {
+		return nil, err
+	}
+	if len(include) > 0 {
+		restorer = restorer.WithIncludeClassNames(include...)
+	}
+	if len(exclude) > 0 {
+		restorer = restorer.WithExcludeClassNames(exclude...)
+	}
+
+	defer c.propertyTypes.invalidate("")
+	ctx, cancel := c.requestContext(options)
+	defer cancel()
+	response, err := restorer.Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	return backupResult(response.ID, response.Backend, response.Path, response.Status, response.Classes, response.Error), nil
+}
+
+// CreateSnapshot backs up the included collections, all of them when
+// includes is empty, to the filesystem backend
+func (c *Client) CreateSnapshot(snapshotID string, includes []string) (map[string]interface{}, error) {
+	return c.BackupCreate(backup.BACKEND_FILESYSTEM, snapshotID, map[string]interface{}{"include": includes})
+}
+
+// RestoreSnapshot restores a snapshot taken with CreateSnapshot
+func (c *Client) RestoreSnapshot(snapshotID string) (map[string]interface{}, error) {
+	return c.BackupRestore(backup.BACKEND_FILESYSTEM, snapshotID, nil)
+}
+
+// BackupCreateStatus returns the status of a backup as {status, path, error}
+func (c *Client) BackupCreateStatus(backend string, backupID string) (map[string]interface{}, error) {
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	response, err := c.client.Backup().CreateStatusGetter().
+		WithBackend(backend).
+		WithBackupID(backupID).
+		Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	return backupStatus(response.Status, response.Path, response.Error), nil
+}
+
+// BackupRestoreStatus returns the status of a backup restore as {status,
+// path, error}
+func (c *Client) BackupRestoreStatus(backend string, backupID string) (map[string]interface{}, error) {
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	response, err := c.client.Backup().RestoreStatusGetter().
+		WithBackend(backend).
+		WithBackupID(backupID).
+		Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	return backupStatus(response.Status, response.Path, response.Error), nil
+}
+
+// WaitForBackup polls the status of a backup, kind is create or restore,
+// until it is SUCCESS, FAILED or CANCELED or timeoutMs (default 60000)
+// elapses. It returns the last {status, path, error} of the backup with the
+// time waited as durationMs. A failed backup is reported in status, not as
+// an error.
+func (c *Client) WaitForBackup(backend string, backupID string, kind string, timeoutMs int) (map[string]interface{}, error) {
+	var getStatus func(string, string) (map[string]interface{}, error)
+	switch kind {
+	case backupKindCreate:
+		getStatus = c.BackupCreateStatus
+	case backupKindRestore:
+		getStatus = c.BackupRestoreStatus
+	default:
+		return nil, fmt.Errorf("invalid backup kind: %s (valid options: create, restore)", kind)
+	}
+	timeout := defaultWaitTimeout
+	if timeoutMs > 0 {
+		timeout = time.Duration(timeoutMs) * time.Millisecond
+	}
+
+	var status map[string]interface{}
+	waited, err := pollUntil("backup "+backupID, timeout, defaultWaitPollInterval, 0, func() ([]string, error) {
+		var err error
+		if status, err = getStatus(backend, backupID); err != nil {
+			return nil, err
+		}
+		if current, _ := status["status"].(string); !terminalBackupStatuses[current] {
+			return []string{"status " + current}, nil
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	status["durationMs"] = waited.Milliseconds()
+	return status, nil
+}
+
+// backupClassNames reads the include and exclude collection lists of the
+// options of BackupCreate and BackupRestore
+func backupClassNames(options map[string]interface{}) ([]string, []string, error) {
+	lists := make([][]string, 2)
+	for i, key := range []string{"include", "exclude"} {
+		var entries []interface{}
+		switch v := options[key].(type) {
+		case nil:
+			continue
+		case []string:
+			lists[i] = v
+			continue
+		case []interface{}:
+			entries = v
+		default:
+			return nil, nil, fmt.Errorf("%s must be a list of collection names", key)
+		}
+		for _, entry := range entries {
+			name, ok := entry.(string)
+			if !ok || name == "" {
+				return nil, nil, fmt.Errorf("%s must be a list of collection names", key)
+			}
+			lists[i] = append(lists[i], name)
+		}
+	}
+	return lists[0], lists[1], nil
+}
+
+func backupStatus(status *string, path, backupErr string) map[string]interface{} {
+	result := map[string]interface{}{
+		"status": "",
+		"path":   path,
+		"error":  backupErr,
+	}
+	if status != nil {
+		result["status"] = *status
+	}
+	return result
+}
+
+func backupResult(id, backend, path string, status *string, classes []string, backupErr string) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":      id,
+		"backend": backend,
+		"path":    path,
+		"status":  "",
+		"classes": classes,
+		"error":   backupErr,
+	}
+	if status != nil {
+		result["status"] = *status
+	}
+	return result
+}
@@ -0,0 +1,425 @@
+package weaviate
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/grafana/sobek"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BatchCreateWithOptions creates objects like BatchCreate, with options:
+// batchSize splits the objects into chunks of at most batchSize objects, sent
+// one after the other in order (default: a single chunk)
+// protocol is grpc or rest, the default is grpc unless objects have
+// multi-vectors, which are only sent over rest
+// consistencyLevel overrides the client default set by SetConsistencyLevel
+// concurrency sends the chunks from that many parallel workers (default 1)
+// The result holds the per-object results, the same as those of BatchCreate,
+// under results in the order of the objects, the {index, size, worker, durationMs, errors} of every chunk under
+// chunks, the successful and failed object counts, the protocol used,
+// workersUsed and the {worker, chunks, objects, durationMs, objectsPerSecond}
+// of every worker under workers. A chunk whose request fails is reported with
+// its error and the remaining chunks are still sent.
+func (c *Client) BatchCreateWithOptions(objects []map[string]interface{}, opts map[string]interface{}) (map[string]interface{}, error) {
+	modelObjects, multiVectors, err := c.buildBatchObjects(objects)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol, err := c.batchProtocol(GetStringValue(opts, "protocol"), multiVectors)
+	if err != nil {
+		return nil, err
+	}
+	consistencyLevel, err := c.consistencyLevelOption(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := len(modelObjects)
+	if size, ok := ToInt(opts["batchSize"]); ok && size > 0 {
+		batchSize = size
+	}
+
+	concurrency := 1
+	if n, ok := ToInt(opts["concurrency"]); ok && n > 0 {
+		concurrency = n
+	}
+
+	chunkCount := 0
+	if batchSize > 0 {
+		chunkCount = (len(modelObjects) + batchSize - 1) / batchSize
+	}
+	workersUsed := concurrency
+	if workersUsed > chunkCount {
+		workersUsed = chunkCount
+	}
+
+	results := make([]map[string]interface{}, len(modelObjects))
+	chunks := make([]map[string]interface{}, chunkCount)
+	workerObjects := make([]int, workersUsed)
+	workerChunks := make([]int, workersUsed)
+	workerDurations := make([]time.Duration, workersUsed)
+
+	begin := time.Now()
+	// every chunk writes to its own slots, workers need no locking
+	runWorkers(chunkCount, workersUsed, func(worker, index int) {
+		start := index * batchSize
+		end := start + batchSize
+		if end > len(modelObjects) {
+			end = len(modelObjects)
+		}
+		var chunkMultiVectors []map[string][][]float32
+		if multiVectors != nil {
+			chunkMultiVectors = multiVectors[start:end]
+		}
+
+		ctx, cancel := c.requestContext(opts)
+		defer cancel()
+		chunkBegin := time.Now()
+		chunkResults, err := c.sendBatch(ctx, modelObjects[start:end], chunkMultiVectors, protocol, consistencyLevel)
+		duration := time.Since(chunkBegin)
+		chunk := c.withServedBy(ctx, map[string]interface{}{
+			"index":      index,
+			"size":       end - start,
+			"worker":     worker,
+			"durationMs": duration.Milliseconds(),
+		})
+		workerObjects[worker] += end - start
+		workerChunks[worker]++
+		workerDurations[worker] += duration
+
+		if err != nil {
+			// Report every object of the failed chunk so results stay aligned
+			// with the input
+			copy(results[start:end], batchErrorResults(modelObjects[start:end], start, err))
+			chunk["errors"] = end - start
+			chunk["error"] = err.Error()
+		} else {
+			chunkFailed := 0
+			for i, res := range batchResults(chunkResults, start) {
+				if res["status"] == "error" {
+					chunkFailed++
+				}
+				results[start+i] = res
+			}
+			chunk["errors"] = chunkFailed
+		}
+		chunks[index] = chunk
+	})
+
+	failed := 0
+	for _, chunk := range chunks {
+		failed += chunk["errors"].(int)
+	}
+
+	workers := make([]map[string]interface{}, workersUsed)
+	for w := range workers {
+		throughput := 0.0
+		if seconds := workerDurations[w].Seconds(); seconds > 0 {
+			throughput = float64(workerObjects[w]) / seconds
+		}
+		workers[w] = map[string]interface{}{
+			"worker":           w,
+			"chunks":           workerChunks[w],
+			"objects":          workerObjects[w],
+			"durationMs":       workerDurations[w].Milliseconds(),
+			"objectsPerSecond": throughput,
+		}
+	}
+
+	return map[string]interface{}{
+		"results":     results,
+		"chunks":      chunks,
+		"successful":  len(results) - failed,
+		"failed":      failed,
+		"protocol":    protocol,
+		"workersUsed": workersUsed,
+		"workers":     workers,
+		"durationMs":  time.Since(begin).Milliseconds(),
+	}, nil
+}
+
+// defaultStreamBatchSize is the batch size of BatchCreateStream
+const defaultStreamBatchSize = 100
+
+// BatchCreateStream creates count objects in a collection without building
+// them all in JS first. generator is called with the index of each object
+// and returns it like an element of BatchCreate (properties, vector, vectors,
+// id, tenant), the class defaults to className. Objects are sent in batches
+// of batchSize (default 100) as soon as a batch is full, and the generator is
+// only called again once the previous batch was sent. protocol and
+// consistencyLevel are the same as for BatchCreateWithOptions.
+// The result holds the sent, successful and failed object counts, the number
+// of batches and durationMs. A generator error or a failed batch request
+// stops the stream and is reported under error, sent is then the number of
+// objects sent before.
+func (c *Client) BatchCreateStream(className string, count int, opts map[string]interface{}, generator func(int) (map[string]interface{}, error)) (map[string]interface{}, error) {
+	if generator == nil {
+		return nil, fmt.Errorf("generator is required")
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("count must not be negative")
+	}
+	requestedProtocol := GetStringValue(opts, "protocol")
+	if _, err := c.batchProtocol(requestedProtocol, nil); err != nil {
+		return nil, err
+	}
+	consistencyLevel, err := c.consistencyLevelOption(opts)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := defaultStreamBatchSize
+	if size, ok := ToInt(opts["batchSize"]); ok && size > 0 {
+		batchSize = size
+	}
+
+	start := time.Now()
+	sent, failed, batches := 0, 0, 0
+	result := func(streamErr error) map[string]interface{} {
+		res := map[string]interface{}{
+			"sent":       sent,
+			"successful": sent - failed,
+			"failed":     failed,
+			"batches":    batches,
+			"durationMs": time.Since(start).Milliseconds(),
+		}
+		if streamErr != nil {
+			res["error"] = streamErr.Error()
+		}
+		return res
+	}
+
+	batch := make([]map[string]interface{}, 0, batchSize)
+	for index := 0; index < count; {
+		batch = batch[:0]
+		for ; index < count && len(batch) < batchSize; index++ {
+			obj, err := generator(index)
+			if err != nil {
+				return result(fmt.Errorf("generator failed at index %d: %w", index, err)), nil
+			}
+			if obj == nil {
+				return result(fmt.Errorf("generator returned no object at index %d", index)), nil
+			}
+			if _, ok := obj["class"]; !ok {
+				obj["class"] = className
+			}
+			batch = append(batch, obj)
+		}
+
+		modelObjects, multiVectors, err := c.buildBatchObjects(batch)
+		if err != nil {
+			return result(fmt.Errorf("batch %d: %w", batches, err)), nil
+		}
+		protocol, err := c.batchProtocol(requestedProtocol, multiVectors)
+		if err != nil {
+			return result(fmt.Errorf("batch %d: %w", batches, err)), nil
+		}
+		ctx, cancel := c.requestContext(opts)
+		responses, err := c.sendBatch(ctx, modelObjects, multiVectors, protocol, consistencyLevel)
+		cancel()
+		if err != nil {
+			return result(fmt.Errorf("batch %d: %w", batches, err)), nil
+		}
+
+		batches++
+		sent += len(batch)
+		for _, res := range batchResults(responses, 0) {
+			if res["status"] == "error" {
+				failed++
+			}
+		}
+	}
+
+	return result(nil), nil
+}
+
+// streamedBatch is a batch read from a stream, ready to be sent
+type streamedBatch struct {
+	objects      []*models.Object
+	multiVectors []map[string][][]float32
+}
+
+// BatchCreateStreaming creates the objects read from stream in batches of
+// batchSize (default 100). stream is a JS function or a Go func returning
+// the next object, or null once the stream is done, or a Go channel of
+// objects. Objects are read while the previous batch is being sent, so the
+// script produces objects during the network round-trip. options is an
+// optional map of:
+// className, the class of objects without one
+// protocol and consistencyLevel, the same as for BatchCreateWithOptions
+// The result is the same as for BatchCreateStream, and the stream stops at
+// the first invalid object or failed batch request.
+func (c *Client) BatchCreateStreaming(stream interface{}, batchSize int, options map[string]interface{}) (map[string]interface{}, error) {
+	next, err := streamSource(stream)
+	if err != nil {
+		return nil, err
+	}
+	requestedProtocol := GetStringValue(options, "protocol")
+	if _, err := c.batchProtocol(requestedProtocol, nil); err != nil {
+		return nil, err
+	}
+	consistencyLevel, err := c.consistencyLevelOption(options)
+	if err != nil {
+		return nil, err
+	}
+	if batchSize <= 0 {
+		batchSize = defaultStreamBatchSize
+	}
+	className := GetStringValue(options, "className")
+
+	start := time.Now()
+	var (
+		mu                    sync.Mutex
+		sent, failed, batches int
+		streamErr             error
+	)
+	failure := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		return streamErr
+	}
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if streamErr == nil {
+			streamErr = err
+		}
+	}
+
+	// a single sender keeps the batches in order, the buffer lets the next
+	// batch be read while one is in flight
+	pending := make(chan streamedBatch, 1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		index := 0
+		for batch := range pending {
+			index++
+			if failure() != nil {
+				continue
+			}
+			protocol, err := c.batchProtocol(requestedProtocol, batch.multiVectors)
+			if err != nil {
+				fail(fmt.Errorf("batch %d: %w", index-1, err))
+				continue
+			}
+			ctx, cancel := c.requestContext(options)
+			responses, err := c.sendBatch(ctx, batch.objects, batch.multiVectors, protocol, consistencyLevel)
+			cancel()
+			if err != nil {
+				fail(fmt.Errorf("batch %d: %w", index-1, err))
+				continue
+			}
+			batchFailed := 0
+			for _, res := range batchResults(responses, 0) {
+				if res["status"] == "error" {
+					batchFailed++
+				}
+			}
+			mu.Lock()
+			sent += len(batch.objects)
+			failed += batchFailed
+			batches++
+			mu.Unlock()
+		}
+	}()
+	// the sender is also stopped when a JS callback throws
+	stopped := false
+	stop := func() {
+		if !stopped {
+			stopped = true
+			close(pending)
+			wg.Wait()
+		}
+	}
+	defer stop()
+
+	read := 0
+	flush := func(objects []map[string]interface{}) bool {
+		modelObjects, multiVectors, err := c.buildBatchObjects(objects)
+		if err != nil {
+			fail(fmt.Errorf("objects %d to %d: %w", read-len(objects), read-1, err))
+			return false
+		}
+		pending <- streamedBatch{objects: modelObjects, multiVectors: multiVectors}
+		return true
+	}
+
+	batch := make([]map[string]interface{}, 0, batchSize)
+	for failure() == nil {
+		obj, err := next()
+		if err != nil {
+			fail(fmt.Errorf("stream failed at object %d: %w", read, err))
+			break
+		}
+		if obj == nil {
+			if len(batch) > 0 {
+				flush(batch)
+			}
+			break
+		}
+		if _, ok := obj["class"]; !ok && className != "" {
+			obj["class"] = className
+		}
+		batch = append(batch, obj)
+		read++
+		if len(batch) == batchSize {
+			if !flush(batch) {
+				break
+			}
+			batch = make([]map[string]interface{}, 0, batchSize)
+		}
+	}
+	stop()
+
+	result := map[string]interface{}{
+		"sent":       sent,
+		"successful": sent - failed,
+		"failed":     failed,
+		"batches":    batches,
+		"durationMs": time.Since(start).Milliseconds(),
+	}
+	if streamErr != nil {
+		result["error"] = streamErr.Error()
+	}
+	return result, nil
+}
+
+// streamSource returns a function reading the next object of a stream, nil
+// once the stream is done
+func streamSource(stream interface{}) (func() (map[string]interface{}, error), error) {
+	switch source := stream.(type) {
+	case func() (map[string]interface{}, error):
+		return source, nil
+	case func() map[string]interface{}:
+		return func() (map[string]interface{}, error) { return source(), nil }, nil
+	case chan map[string]interface{}:
+		return channelSource(source), nil
+	case <-chan map[string]interface{}:
+		return channelSource(source), nil
+	case func(sobek.FunctionCall) sobek.Value:
+		// a JS function, called on the VU goroutine like any other callback
+		return func() (map[string]interface{}, error) {
+			value := source(sobek.FunctionCall{This: sobek.Undefined()})
+			if value == nil || sobek.IsUndefined(value) || sobek.IsNull(value) {
+				return nil, nil
+			}
+			obj, ok := value.Export().(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("stream function must return an object or null")
+			}
+			return obj, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("stream must be a function returning objects or a channel of objects")
+	}
+}
+
+func channelSource(objects <-chan map[string]interface{}) func() (map[string]interface{}, error) {
+	return func() (map[string]interface{}, error) {
+		return <-objects, nil
+	}
+}
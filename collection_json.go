@@ -0,0 +1,95 @@
+package weaviate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// CreateCollectionFromJSON creates a collection from a class definition in
+// the JSON format of the Weaviate REST API, e.g. a schema exported from
+// another cluster. Unlike CreateCollection every class setting is kept as is.
+func (c *Client) CreateCollectionFromJSON(collectionJSON string) error {
+	collection, err := parseCollectionJSON(collectionJSON)
+	if err != nil {
+		return err
+	}
+
+	defer c.propertyTypes.invalidate(collection.Class)
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	return requestError(ctx, c.client.Schema().ClassCreator().
+		WithClass(collection).
+		Do(ctx))
+}
+
+// ExportCollectionJSON returns the definition of a collection as a JSON string
+// that can be passed to CreateCollectionFromJSON
+func (c *Client) ExportCollectionJSON(collectionName string) (string, error) {
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	collection, err := c.client.Schema().ClassGetter().
+		WithClassName(collectionName).
+		Do(ctx)
+	if err != nil {
+		return "", requestError(ctx, err)
+	}
+
+	data, err := json.Marshal(collection)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode collection %s: %w", collectionName, err)
+	}
+	return string(data), nil
+}
+
+// GetSchemaJSON returns the definitions of all collections as the JSON
+// schema of the Weaviate REST API, {"classes": [...]}
+func (c *Client) GetSchemaJSON() (string, error) {
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	schema, err := c.client.Schema().Getter().Do(ctx)
+	if err != nil {
+		return "", requestError(ctx, err)
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode schema: %w", err)
+	}
+	return string(data), nil
+}
+
+// parseCollectionJSON decodes and validates a class definition, reporting the
+// JSON path of the offending field on failure
+func parseCollectionJSON(collectionJSON string) (*models.Class, error) {
+	var collection models.Class
+	decoder := json.NewDecoder(bytes.NewReader([]byte(collectionJSON)))
+	if err := decoder.Decode(&collection); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		var syntaxErr *json.SyntaxError
+		switch {
+		case errors.As(err, &typeErr):
+			return nil, fmt.Errorf("invalid collection JSON at %s: expected %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value)
+		case errors.As(err, &syntaxErr):
+			return nil, fmt.Errorf("invalid collection JSON at offset %d: %w", syntaxErr.Offset, err)
+		default:
+			return nil, fmt.Errorf("invalid collection JSON: %w", err)
+		}
+	}
+
+	if collection.Class == "" {
+		return nil, fmt.Errorf("invalid collection JSON at class: class name is required")
+	}
+
+	// validation errors from the generated models already name the field path,
+	// e.g. "properties.0.tokenization in body should be one of [...]"
+	if err := collection.Validate(strfmt.Default); err != nil {
+		return nil, fmt.Errorf("invalid collection JSON: %w", err)
+	}
+
+	return &collection, nil
+}
@@ -0,0 +1,51 @@
+package weaviate
+
+import "sync"
+
+// forEachConcurrently calls fn for every index in [0, n) using at most
+// concurrency goroutines and returns the error of every call, by index
+func forEachConcurrently(n int, concurrency int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// runWorkers calls fn for every index in [0, n) from workers goroutines
+// pulling the indexes in order, passing the number of the worker to fn
+func runWorkers(n int, workers int, fn func(worker, i int)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := range indexes {
+				fn(worker, i)
+			}
+		}(w)
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
@@ -0,0 +1,125 @@
+package weaviate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/data/replication"
+)
+
+// consistencyLevels maps the lowercase consistency level names accepted from
+// JS to the values expected by Weaviate
+var consistencyLevels = map[string]string{
+	"all":    replication.ConsistencyLevel.ALL,
+	"one":    replication.ConsistencyLevel.ONE,
+	"quorum": replication.ConsistencyLevel.QUORUM,
+}
+
+// normalizeConsistencyLevel validates a consistency level, case-insensitive
+func normalizeConsistencyLevel(level string) (string, error) {
+	normalized, ok := consistencyLevels[strings.ToLower(level)]
+	if !ok {
+		return "", fmt.Errorf("invalid consistency level: %s (valid options: one, quorum, all)", level)
+	}
+	return normalized, nil
+}
+
+// SetConsistencyLevel sets the consistency level used by every operation that
+// does not set consistencyLevel itself. An empty level removes the default.
+func (c *Client) SetConsistencyLevel(level string) error {
+	if level == "" {
+		c.consistencyLevel = ""
+		return nil
+	}
+	normalized, err := normalizeConsistencyLevel(level)
+	if err != nil {
+		return err
+	}
+	c.consistencyLevel = normalized
+	return nil
+}
+
+// SetTenant sets the tenant used by every operation that does not set tenant
+// itself. An empty tenant removes the default.
+func (c *Client) SetTenant(tenant string) {
+	c.tenant = tenant
+}
+
+// tenantOption returns the tenant of an options map, or the client default
+// when the options do not set one
+func (c *Client) tenantOption(options map[string]interface{}) string {
+	if tenant, ok := options["tenant"].(string); ok && tenant != "" {
+		return tenant
+	}
+	return c.tenant
+}
+
+// consistencyLevelOption returns the consistencyLevel of an options map, or
+// the client default when the options do not set one
+func (c *Client) consistencyLevelOption(options map[string]interface{}) (string, error) {
+	level, ok := options["consistencyLevel"].(string)
+	if !ok || level == "" {
+		return c.consistencyLevel, nil
+	}
+	return normalizeConsistencyLevel(level)
+}
+
+// baseContext returns the parent context of every request, canceled with
+// ErrClientClosed once the client is closed
+func (c *Client) baseContext() context.Context {
+	if c.closed == nil || !c.closed.Load() {
+		return context.Background()
+	}
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(ErrClientClosed)
+	return ctx
+}
+
+// requestContext returns the context of a single operation, bounded by the
+// requestTimeoutMs of options or else by the client default. With several
+// hosts the requests of the operation all go to the host picked here.
+func (c *Client) requestContext(options map[string]interface{}) (context.Context, context.CancelFunc) {
+	timeout := c.requestTimeout
+	if ms, ok := ToInt(options["requestTimeoutMs"]); ok && ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+	ctx := c.baseContext()
+	if c.hosts != nil {
+		ctx = withHost(ctx, c.hosts.pick())
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// Clone returns a client sharing the connections of c, with the defaults in
+// overrides applied: tenant and consistencyLevel. The embedded server, if
+// any, stays owned by c.
+func (c *Client) Clone(overrides map[string]interface{}) (*Client, error) {
+	clone := *c
+	clone.embedded = nil
+	for key, value := range overrides {
+		switch key {
+		case "tenant":
+			tenant, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("tenant must be a string")
+			}
+			clone.SetTenant(tenant)
+		case "consistencyLevel":
+			level, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("consistencyLevel must be a string")
+			}
+			if err := clone.SetConsistencyLevel(level); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported clone override: %s (valid options: tenant, consistencyLevel)", key)
+		}
+	}
+	return &clone, nil
+}
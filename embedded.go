@@ -0,0 +1,289 @@
+package weaviate
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	defaultEmbeddedVersion  = "1.27.0"
+	defaultEmbeddedPort     = 8079
+	defaultEmbeddedGrpcPort = 50060
+	defaultEmbeddedTimeout  = 30
+)
+
+// embeddedVersionPattern matches the Weaviate release versions, which are
+// part of the download URL and of the cached binary name
+var embeddedVersionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+(-[0-9A-Za-z.]+)?$`)
+
+// embeddedDownloadClient downloads the release archives, the binaries are
+// around 100MB
+var embeddedDownloadClient = &http.Client{Timeout: 10 * time.Minute}
+
+// NewEmbedded starts a local Weaviate server as a child process and returns
+// a client connected to it. The go-client has no embedded mode, so like the
+// Python and TypeScript clients the Weaviate release binary is downloaded
+// once into the cache directory and run locally. Linux only.
+// options is a map of:
+// version is the Weaviate version to run (default 1.27.0)
+// sha256 is the hex SHA-256 of the release archive, by default it is read
+// from the checksums.txt of the release. A download not matching it fails.
+// port is the REST port (default 8079)
+// grpcPort is the gRPC port (default 50060)
+// env is a map of extra environment variables, e.g. ENABLE_MODULES
+// binaryPath runs an existing Weaviate binary instead of downloading one
+// persistenceDataPath is where data is stored (default a temporary directory,
+// removed when the server is stopped)
+// timeout is how long to wait for the server to start, in seconds (default 30)
+// Call StopEmbedded to stop the server.
+func (w *Weaviate) NewEmbedded(options map[string]interface{}) (*Client, error) {
+	port := defaultEmbeddedPort
+	if p, ok := ToInt(options["port"]); ok {
+		port = p
+	}
+	grpcPort := defaultEmbeddedGrpcPort
+	if p, ok := ToInt(options["grpcPort"]); ok {
+		grpcPort = p
+	}
+	if port <= 0 || port > 65535 || grpcPort <= 0 || grpcPort > 65535 {
+		return nil, fmt.Errorf("invalid embedded ports: port %d, grpcPort %d", port, grpcPort)
+	}
+
+	binary := GetStringValue(options, "binaryPath")
+	if binary == "" {
+		version := strings.TrimPrefix(GetStringValue(options, "version"), "v")
+		if version == "" {
+			version = defaultEmbeddedVersion
+		}
+		if !embeddedVersionPattern.MatchString(version) {
+			return nil, fmt.Errorf("invalid embedded weaviate version: %s (expected a version such as %s)", version, defaultEmbeddedVersion)
+		}
+		path, err := embeddedBinary(version, strings.ToLower(GetStringValue(options, "sha256")))
+		if err != nil {
+			return nil, fmt.Errorf("failed to install embedded weaviate: %w", err)
+		}
+		binary = path
+	} else if _, err := os.Stat(binary); err != nil {
+		return nil, fmt.Errorf("embedded weaviate binary not found: %w", err)
+	}
+
+	server := &embeddedServer{}
+	dataPath := GetStringValue(options, "persistenceDataPath")
+	if dataPath == "" {
+		dir, err := os.MkdirTemp("", "weaviate-embedded-")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create embedded data directory: %w", err)
+		}
+		dataPath = dir
+		server.tempDataPath = dir
+	}
+
+	cmd := exec.Command(binary, "--host", "127.0.0.1", "--port", fmt.Sprint(port), "--scheme", "http")
+	cmd.Env = append(os.Environ(),
+		"AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED=true",
+		"DEFAULT_VECTORIZER_MODULE=none",
+		"CLUSTER_HOSTNAME=Embedded",
+		"PERSISTENCE_DATA_PATH="+dataPath,
+		fmt.Sprintf("GRPC_PORT=%d", grpcPort),
+	)
+	if env, ok := options["env"].(map[string]interface{}); ok {
+		for key, value := range env {
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%v", key, value))
+		}
+	}
+	server.cmd = cmd
+	if err := cmd.Start(); err != nil {
+		server.removeData()
+		return nil, fmt.Errorf("failed to start embedded weaviate: %w", err)
+	}
+
+	timeout := float64(defaultEmbeddedTimeout)
+	if t, ok := ToFloat64(options["timeout"]); ok && t > 0 {
+		timeout = t
+	}
+	client, err := w.NewClient(map[string]interface{}{
+		"host":     fmt.Sprintf("127.0.0.1:%d", port),
+		"scheme":   "http",
+		"grpcHost": fmt.Sprintf("127.0.0.1:%d", grpcPort),
+		"timeout":  timeout,
+	})
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		server.removeData()
+		return nil, fmt.Errorf("embedded weaviate did not start: %w", err)
+	}
+	client.embedded = server
+	return client, nil
+}
+
+// embeddedServer is a server process started by NewEmbedded
+type embeddedServer struct {
+	cmd *exec.Cmd
+	// tempDataPath is the data directory created for the server, removed
+	// once it exits. A persistenceDataPath of the options is kept.
+	tempDataPath string
+}
+
+// removeData removes the data directory created for the server, if any
+func (s *embeddedServer) removeData() error {
+	if s.tempDataPath == "" {
+		return nil
+	}
+	if err := os.RemoveAll(s.tempDataPath); err != nil {
+		return fmt.Errorf("failed to remove embedded data directory: %w", err)
+	}
+	return nil
+}
+
+// StopEmbedded stops the server started by NewEmbedded and removes its
+// temporary data directory
+func (c *Client) StopEmbedded() error {
+	if c.embedded == nil {
+		return fmt.Errorf("client is not connected to an embedded weaviate")
+	}
+	if err := c.embedded.cmd.Process.Signal(os.Interrupt); err != nil {
+		return fmt.Errorf("failed to stop embedded weaviate: %w", err)
+	}
+	// the process exits with a signal status after an interrupt
+	_ = c.embedded.cmd.Wait()
+	err := c.embedded.removeData()
+	c.embedded = nil
+	return err
+}
+
+// embeddedBinary returns the path of the Weaviate binary for a version,
+// downloading it from the GitHub releases when it is not cached yet. The
+// archive must match checksum, or else the checksum published with the
+// release.
+func embeddedBinary(version, checksum string) (string, error) {
+	if runtime.GOOS != "linux" {
+		return "", fmt.Errorf("embedded weaviate downloads are only supported on linux, use binaryPath")
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(cacheDir, "weaviate-embedded")
+	binary := filepath.Join(dir, "weaviate-"+version)
+	if _, err := os.Stat(binary); err == nil {
+		return binary, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	releaseURL := fmt.Sprintf("https://github.com/weaviate/weaviate/releases/download/v%s/", version)
+	archiveName := fmt.Sprintf("weaviate-v%s-Linux-%s.tar.gz", version, runtime.GOARCH)
+	if checksum == "" {
+		if checksum, err = releaseChecksum(releaseURL, archiveName); err != nil {
+			return "", err
+		}
+	}
+
+	url := releaseURL + archiveName
+	resp, err := download(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	// write to a temporary file first so a partial or unverified download
+	// is never used
+	tmp := binary + ".download"
+	defer os.Remove(tmp)
+	hash := sha256.New()
+	body := io.TeeReader(resp.Body, hash)
+	if err := extractBinary(body, tmp); err != nil {
+		return "", fmt.Errorf("download %s: %w", url, err)
+	}
+	// the checksum covers the whole archive
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return "", fmt.Errorf("download %s: %w", url, err)
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != checksum {
+		return "", fmt.Errorf("download %s: checksum mismatch, got sha256 %s, expected %s", url, sum, checksum)
+	}
+	return binary, os.Rename(tmp, binary)
+}
+
+// download starts a GET request of url, failing on other status codes than
+// 200
+func download(url string) (*http.Response, error) {
+	resp, err := embeddedDownloadClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("download %s: status code %d", url, resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// releaseChecksum reads the SHA-256 of archiveName from the checksums.txt of
+// a release, made of "<sha256>  <file name>" lines
+func releaseChecksum(releaseURL, archiveName string) (string, error) {
+	resp, err := download(releaseURL + "checksums.txt")
+	if err != nil {
+		return "", fmt.Errorf("failed to read the release checksums, pass sha256 or binaryPath: %w", err)
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == archiveName {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read the release checksums: %w", err)
+	}
+	return "", fmt.Errorf("the release checksums have no entry for %s, pass sha256 or binaryPath", archiveName)
+}
+
+// extractBinary writes the weaviate binary of a gzipped tar archive to path
+func extractBinary(r io.Reader, path string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return fmt.Errorf("archive has no weaviate binary")
+		}
+		if err != nil {
+			return err
+		}
+		if filepath.Base(header.Name) != "weaviate" || header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(file, archive); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}
+}
@@ -0,0 +1,76 @@
+package weaviate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+)
+
+// NotFoundError is returned when a requested resource does not exist
+type NotFoundError struct {
+	// Resource is the kind of resource, e.g. tenant
+	Resource string
+	// Name identifies the missing resource
+	Name string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found", e.Resource, e.Name)
+}
+
+// ErrClientClosed is returned by the operations of a client after Close
+var ErrClientClosed = errors.New("client closed")
+
+// TimeoutError is returned when an operation does not complete within its
+// request timeout, see requestTimeoutMs
+type TimeoutError struct {
+	// Err is the error the operation was aborted with
+	Err error
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("request timed out: %v", e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// requestError returns err as a *TimeoutError when it was caused by the
+// deadline of the request context ctx, and as ErrClientClosed when the
+// client was closed
+func requestError(ctx context.Context, err error) error {
+	if err != nil && errors.Is(context.Cause(ctx), ErrClientClosed) {
+		return ErrClientClosed
+	}
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &TimeoutError{Err: err}
+	}
+	return err
+}
+
+// isNotFound reports whether err is a 404 response from Weaviate
+func isNotFound(err error) bool {
+	return httpStatusCode(err) == http.StatusNotFound
+}
+
+// isAlreadyExists reports whether err is the response of Weaviate to an
+// object insert with an id that is already taken, or to the creation of a
+// collection that exists
+func isAlreadyExists(err error) bool {
+	return httpStatusCode(err) == http.StatusUnprocessableEntity && strings.Contains(err.Error(), "already exists")
+}
+
+// httpStatusCode returns the status code of an unexpected Weaviate response,
+// or 0 when err did not come from a response
+func httpStatusCode(err error) int {
+	var clientErr *fault.WeaviateClientError
+	if errors.As(err, &clientErr) {
+		return clientErr.StatusCode
+	}
+	return 0
+}
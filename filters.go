@@ -0,0 +1,254 @@
+package weaviate
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+)
+
+// geoDistanceUnits maps supported distance units to their value in meters
+var geoDistanceUnits = map[string]float64{
+	"m":  1,
+	"km": 1000,
+	"mi": 1609.344,
+	"ft": 0.3048,
+	"yd": 0.9144,
+}
+
+// whereOperators are the operators accepted in where filters. Unknown
+// operators are rejected, an empty operator would match the wrong objects.
+var whereOperators = map[string]filters.WhereOperator{
+	"And":              filters.And,
+	"Or":               filters.Or,
+	"Not":              filters.Not,
+	"Equal":            filters.Equal,
+	"NotEqual":         filters.NotEqual,
+	"GreaterThan":      filters.GreaterThan,
+	"GreaterThanEqual": filters.GreaterThanEqual,
+	"LessThan":         filters.LessThan,
+	"LessThanEqual":    filters.LessThanEqual,
+	"Like":             filters.Like,
+	"WithinGeoRange":   filters.WithinGeoRange,
+	"IsNull":           filters.IsNull,
+	"ContainsAny":      filters.ContainsAny,
+	"ContainsAll":      filters.ContainsAll,
+}
+
+// buildWhereFilter converts a JS where filter map into a where builder.
+// The object UUID is filtered with the path id or _id and valueText, the
+// creation and update times with _creationTimeUnix and _lastUpdateTimeUnix
+// and valueDate, which requires indexTimestamps on the collection.
+func buildWhereFilter(whereFilter map[string]interface{}) (*filters.WhereBuilder, error) {
+	where := filters.Where()
+
+	operatorName, ok := whereFilter["operator"].(string)
+	if !ok {
+		return nil, fmt.Errorf("where filter requires an operator")
+	}
+	operator, ok := whereOperators[operatorName]
+	if !ok {
+		return nil, fmt.Errorf("invalid where operator: %s", operatorName)
+	}
+	// IsNull requires indexNullState to be enabled on the collection
+	if operator == filters.IsNull {
+		if _, ok := whereFilter["valueBoolean"].(bool); !ok {
+			return nil, fmt.Errorf("IsNull operator requires a boolean valueBoolean")
+		}
+	}
+	where.WithOperator(operator)
+
+	// Compound filters (And, Or, Not) nest their conditions in operands
+	if operands, ok := whereFilter["operands"].([]interface{}); ok {
+		builders := make([]*filters.WhereBuilder, len(operands))
+		for i, operand := range operands {
+			operandFilter, ok := operand.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("operand at index %d must be an object", i)
+			}
+			builder, err := buildWhereFilter(operandFilter)
+			if err != nil {
+				return nil, fmt.Errorf("operand at index %d: %w", i, err)
+			}
+			builders[i] = builder
+		}
+		where = where.WithOperands(builders)
+	}
+
+	path := GetStringSlice(whereFilter["path"])
+	if path != nil {
+		where = where.WithPath(path)
+	}
+
+	if valueString, ok := whereFilter["valueString"].(string); ok {
+		where = where.WithValueString(valueString)
+	}
+
+	var texts []string
+	if valueText, ok := whereFilter["valueText"].(string); ok {
+		texts = []string{valueText}
+	} else {
+		texts = GetStringSlice(whereFilter["valueText"])
+	}
+	if texts != nil {
+		// id filters are checked here, the server error for a malformed UUID
+		// does not say which one is wrong
+		if isIDPath(path) {
+			for i, id := range texts {
+				if !strfmt.IsUUID(id) {
+					return nil, fmt.Errorf("invalid UUID at index %d of the %s filter: %s", i, path[0], id)
+				}
+			}
+		}
+		where = where.WithValueText(texts...)
+	}
+
+	if valueInt, exists := whereFilter["valueInt"]; exists {
+		values, isSlice := valueInt.([]interface{})
+		if !isSlice {
+			values = []interface{}{valueInt}
+		}
+		ints := make([]int64, len(values))
+		for i, v := range values {
+			n, ok := filterInt(v)
+			if !ok {
+				return nil, fmt.Errorf("invalid valueInt: %v", v)
+			}
+			ints[i] = n
+		}
+		where = where.WithValueInt(ints...)
+	}
+
+	if valueNumber, exists := whereFilter["valueNumber"]; exists {
+		values, isSlice := valueNumber.([]interface{})
+		if !isSlice {
+			values = []interface{}{valueNumber}
+		}
+		numbers := make([]float64, len(values))
+		for i, v := range values {
+			n, ok := ToFloat64(v)
+			if !ok {
+				return nil, fmt.Errorf("invalid valueNumber: %v", v)
+			}
+			numbers[i] = n
+		}
+		where = where.WithValueNumber(numbers...)
+	}
+
+	if valueBoolean, ok := whereFilter["valueBoolean"].(bool); ok {
+		where = where.WithValueBoolean(valueBoolean)
+	} else if valueBoolean, ok := whereFilter["valueBoolean"].([]interface{}); ok {
+		booleans := make([]bool, len(valueBoolean))
+		for i, v := range valueBoolean {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("invalid valueBoolean: %v", v)
+			}
+			booleans[i] = b
+		}
+		where = where.WithValueBoolean(booleans...)
+	}
+
+	if valueDate, exists := whereFilter["valueDate"]; exists {
+		values, isSlice := valueDate.([]interface{})
+		if !isSlice {
+			values = []interface{}{valueDate}
+		}
+		dates := make([]time.Time, len(values))
+		for i, v := range values {
+			date, err := filterDate(v)
+			if err != nil {
+				if isSlice {
+					return nil, fmt.Errorf("invalid valueDate at index %d: %w", i, err)
+				}
+				return nil, fmt.Errorf("invalid valueDate: %w", err)
+			}
+			dates[i] = date
+		}
+		where = where.WithValueDate(dates...)
+	}
+
+	if geoRange, ok := whereFilter["valueGeoRange"].(map[string]interface{}); ok {
+		geo, err := buildGeoRange(geoRange)
+		if err != nil {
+			return nil, err
+		}
+		where = where.WithValueGeoRange(geo)
+	}
+
+	return where, nil
+}
+
+// isIDPath reports whether a filter path targets the object UUID, which
+// Weaviate accepts as id or _id
+func isIDPath(path []string) bool {
+	return len(path) == 1 && (path[0] == "id" || path[0] == "_id")
+}
+
+// filterInt converts a JS number to an int64, rejecting numbers with a
+// fractional part instead of truncating them
+func filterInt(value interface{}) (int64, bool) {
+	if f, ok := value.(float64); ok {
+		if f != math.Trunc(f) {
+			return 0, false
+		}
+		return int64(f), true
+	}
+	n, ok := ToInt(value)
+	return int64(n), ok
+}
+
+// filterDate converts a date filter value, either an RFC3339 string, a
+// number of milliseconds since the epoch or a JS Date
+func filterDate(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		date, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%q, expected RFC3339 format or epoch milliseconds: %w", v, err)
+		}
+		return date, nil
+	}
+	millis, ok := filterInt(value)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%v, expected RFC3339 format or epoch milliseconds", value)
+	}
+	return time.UnixMilli(millis).UTC(), nil
+}
+
+// buildGeoRange reads latitude, longitude and distance (in the given unit,
+// meters by default) from a valueGeoRange map
+func buildGeoRange(geoRange map[string]interface{}) (*filters.GeoCoordinatesParameter, error) {
+	latitude, ok := ToFloat64(geoRange["latitude"])
+	if !ok {
+		return nil, fmt.Errorf("valueGeoRange requires a numeric latitude")
+	}
+	longitude, ok := ToFloat64(geoRange["longitude"])
+	if !ok {
+		return nil, fmt.Errorf("valueGeoRange requires a numeric longitude")
+	}
+	distance, ok := ToFloat64(geoRange["distance"])
+	if !ok {
+		return nil, fmt.Errorf("valueGeoRange requires a numeric distance")
+	}
+
+	unit := "m"
+	if u, ok := geoRange["unit"].(string); ok {
+		unit = strings.ToLower(u)
+	}
+	factor, ok := geoDistanceUnits[unit]
+	if !ok {
+		return nil, fmt.Errorf("invalid distance unit: %s (valid units: m, km, mi, ft, yd)", unit)
+	}
+
+	return &filters.GeoCoordinatesParameter{
+		Latitude:    float32(latitude),
+		Longitude:   float32(longitude),
+		MaxDistance: float32(distance * factor),
+	}, nil
+}
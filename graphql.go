@@ -0,0 +1,980 @@
+package weaviate
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GenerativeSearch runs a GraphQL Get query with a generate argument, for
+// RAG style workloads.
+// singleResultPrompt is the prompt applied to every result object
+// groupedResultTask is the task applied to all results at once
+// groupedResultProperties restricts the properties used by groupedResultTask
+// generate is an optional {provider, model} map selecting the LLM
+// rerank is an optional {property, query} map reranking the results
+// the remaining keys are the retrieval options described in buildGetQuery
+// The results are returned like the near-media searches, with the generated
+// text in their additional generate field.
+func (c *Client) GenerativeSearch(className string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	singleResultPrompt := GetStringValue(query, "singleResultPrompt")
+	groupedResultTask := GetStringValue(query, "groupedResultTask")
+	if singleResultPrompt == "" && groupedResultTask == "" {
+		return nil, fmt.Errorf("singleResultPrompt or groupedResultTask is required")
+	}
+
+	getter, err := c.buildGetQuery(className, query)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := buildFields(query)
+	if err != nil {
+		return nil, err
+	}
+
+	groupedProperties := GetStringSlice(query["groupedResultProperties"])
+	generateOptions, _ := query["generate"].(map[string]interface{})
+	if GetStringValue(generateOptions, "model") == "" {
+		generate := graphql.NewGenerativeSearch()
+		if singleResultPrompt != "" {
+			generate = generate.SingleResult(singleResultPrompt)
+		}
+		if groupedResultTask != "" {
+			generate = generate.GroupedResult(groupedResultTask, groupedProperties...)
+		}
+		getter = getter.WithGenerativeSearch(generate)
+	} else {
+		generate, err := buildGenerateField(singleResultPrompt, groupedResultTask, groupedProperties, generateOptions)
+		if err != nil {
+			return nil, err
+		}
+		// the last field of buildFields is _additional
+		additional := &fields[len(fields)-1]
+		additional.Fields = append(additional.Fields, generate)
+	}
+
+	ctx, cancel := c.requestContext(query)
+	defer cancel()
+	response, err := getter.WithFields(fields...).Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	return parseGetResponse(response, className)
+}
+
+// RawGraphQL runs a GraphQL query string as is, with optional variables, and
+// returns the data of the response. GraphQL errors are returned as an error.
+func (c *Client) RawGraphQL(query string, variables map[string]interface{}) (map[string]interface{}, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	request := models.GraphQLQuery{Query: query}
+	if len(variables) > 0 {
+		request.Variables = variables
+	}
+	var response models.GraphQLResponse
+	if err := c.runREST(http.MethodPost, "/graphql", request, &response, http.StatusOK); err != nil {
+		return nil, err
+	}
+	if len(response.Errors) > 0 {
+		return nil, graphQLError(response.Errors)
+	}
+
+	data := make(map[string]interface{}, len(response.Data))
+	for key, value := range response.Data {
+		data[key] = value
+	}
+	return data, nil
+}
+
+// GraphQLGet runs a GraphQL Get query and returns the results under the
+// objects key. The query map accepts the retrieval options described in
+// buildGetQuery, plus fields, additional and rerank selecting the returned
+// fields.
+// groupBy is an optional {path, groups, objectsPerGroup} map, when set the
+// results are returned under the groups key, each group holding its objects
+func (c *Client) GraphQLGet(className string, query map[string]interface{}) (map[string]interface{}, error) {
+	getter, err := c.buildGetQuery(className, query)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := buildFields(query)
+	if err != nil {
+		return nil, err
+	}
+
+	groupBy, grouped := query["groupBy"].(map[string]interface{})
+	if grouped {
+		builder, err := buildGroupBy(groupBy)
+		if err != nil {
+			return nil, err
+		}
+		getter = getter.WithGroupBy(builder)
+		fields = groupByFields(fields)
+	}
+
+	ctx, cancel := c.requestContext(query)
+	defer cancel()
+	response, err := getter.WithFields(fields...).Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+
+	if grouped {
+		groups, err := parseGroupByResponse(response, className)
+		if err != nil {
+			return nil, err
+		}
+		return c.withServedBy(ctx, map[string]interface{}{"groups": groups}), nil
+	}
+
+	objects, err := parseGetResponse(response, className)
+	if err != nil {
+		return nil, err
+	}
+	return c.withServedBy(ctx, map[string]interface{}{"objects": objects}), nil
+}
+
+// GraphQLBM25 runs a keyword search. query and properties are the BM25
+// arguments, the remaining keys are the same as for GraphQLGet.
+func (c *Client) GraphQLBM25(className string, query map[string]interface{}) (map[string]interface{}, error) {
+	return c.GraphQLGet(className, withSearchArgument(query, "bm25", map[string]interface{}{
+		"query":      query["query"],
+		"properties": query["properties"],
+	}))
+}
+
+// GraphQLHybrid runs a hybrid search. query, alpha, vector, properties and
+// fusionType are the hybrid arguments, the remaining keys are the same as for
+// GraphQLGet.
+func (c *Client) GraphQLHybrid(className string, query map[string]interface{}) (map[string]interface{}, error) {
+	return c.GraphQLGet(className, withSearchArgument(query, "hybrid", query))
+}
+
+// MultiGet fetches the objects with the given ids in a single GraphQL Get
+// query, filtering id with ContainsAny. The objects are returned in the
+// order of ids, ids that do not exist are left out. options takes the same
+// keys as GraphQLGet except groupBy, a where filter is combined with the ids
+// filter.
+func (c *Client) MultiGet(className string, ids []string, options map[string]interface{}) ([]map[string]interface{}, error) {
+	if len(ids) == 0 {
+		return []map[string]interface{}{}, nil
+	}
+
+	idFilter := map[string]interface{}{
+		"operator":  "ContainsAny",
+		"path":      []string{"id"},
+		"valueText": ids,
+	}
+	query := make(map[string]interface{}, len(options)+2)
+	for key, value := range options {
+		query[key] = value
+	}
+	if where, ok := options["where"].(map[string]interface{}); ok {
+		query["where"] = map[string]interface{}{
+			"operator": "And",
+			"operands": []interface{}{idFilter, where},
+		}
+	} else {
+		query["where"] = idFilter
+	}
+	query["limit"] = len(ids)
+	delete(query, "groupBy")
+
+	result, err := c.GraphQLGet(className, query)
+	if err != nil {
+		return nil, err
+	}
+	objects, _ := result["objects"].([]map[string]interface{})
+
+	byID := make(map[string]map[string]interface{}, len(objects))
+	for _, obj := range objects {
+		if id, ok := obj["id"].(string); ok {
+			byID[strings.ToLower(id)] = obj
+		}
+	}
+	ordered := make([]map[string]interface{}, 0, len(objects))
+	for _, id := range ids {
+		id = strings.ToLower(id)
+		if obj, ok := byID[id]; ok {
+			ordered = append(ordered, obj)
+			// a repeated id is returned once
+			delete(byID, id)
+		}
+	}
+	return ordered, nil
+}
+
+// GetReferenceObjects returns the objects the cross-reference property of an
+// object points to, one hop away, as {id, properties, additional} maps.
+// options is an optional map of:
+// collection is the target collection, or a list of them (default every
+// collection in the dataType of the property)
+// fields are the target properties to return, see buildPropertyFields
+// tenant and consistencyLevel are applied to the query
+// A missing source object returns a *NotFoundError.
+func (c *Client) GetReferenceObjects(fromClass string, fromID string, propertyName string, options map[string]interface{}) ([]map[string]interface{}, error) {
+	targets, ok := referenceCollections(options["collection"])
+	if options["collection"] == nil || options["collection"] == "" {
+		var err error
+		if targets, err = c.referenceTargets(fromClass, propertyName); err != nil {
+			return nil, err
+		}
+	} else if !ok {
+		return nil, fmt.Errorf("collection must be a collection name or a list of them")
+	}
+	fields, err := buildPropertyFields([]interface{}{map[string]interface{}{
+		"name":       propertyName,
+		"collection": targets,
+		"fields":     options["fields"],
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	query := map[string]interface{}{
+		"where": map[string]interface{}{
+			"operator":  "Equal",
+			"path":      []string{"id"},
+			"valueText": fromID,
+		},
+	}
+	for _, key := range []string{"tenant", "consistencyLevel", "requestTimeoutMs"} {
+		if value, ok := options[key]; ok {
+			query[key] = value
+		}
+	}
+	getter, err := c.buildGetQuery(fromClass, query)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.requestContext(query)
+	defer cancel()
+	response, err := getter.WithFields(append(fields, graphql.Field{Name: "_additional", Fields: []graphql.Field{{Name: "id"}}})...).Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	objects, err := parseGetResponse(response, fromClass)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, &NotFoundError{Resource: "object", Name: fromClass + "/" + fromID}
+	}
+
+	properties, _ := objects[0]["properties"].(map[string]interface{})
+	refs, _ := properties[propertyName].([]interface{})
+	referenced := make([]map[string]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		if obj, ok := ref.(map[string]interface{}); ok {
+			referenced = append(referenced, convertGraphQLObject(obj))
+		}
+	}
+	return referenced, nil
+}
+
+// referenceTargets returns the collections a cross-reference property of a
+// collection points to
+func (c *Client) referenceTargets(className string, propertyName string) ([]string, error) {
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	class, err := c.client.Schema().ClassGetter().WithClassName(className).Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	for _, property := range class.Properties {
+		if property.Name != propertyName {
+			continue
+		}
+		if !IsReferenceDataType(property.DataType) {
+			return nil, fmt.Errorf("property %s of collection %s is not a cross-reference", propertyName, className)
+		}
+		return property.DataType, nil
+	}
+	return nil, &NotFoundError{Resource: "property", Name: className + "." + propertyName}
+}
+
+// withSearchArgument returns a copy of query with the search argument set
+func withSearchArgument(query map[string]interface{}, key string, argument map[string]interface{}) map[string]interface{} {
+	withArgument := make(map[string]interface{}, len(query)+1)
+	for k, v := range query {
+		withArgument[k] = v
+	}
+	withArgument[key] = argument
+	return withArgument
+}
+
+// buildGetQuery creates a GraphQL Get builder from the retrieval options of
+// a query map:
+// nearVector is a map with vector, certainty, distance and targetVectors,
+// plus weights and combinationMethod for multi-target search
+// nearText is a map with concepts, certainty, distance and targetVectors,
+// plus moveTo and moveAwayFrom {concepts, objects, force} maps
+// hybrid is a map with query, alpha, vector and properties
+// bm25 is a map with query and properties
+// where is a where filter, see buildWhereFilter
+// limit and offset paginate the results
+// autoCut limits the results to the given number of score jumps
+// sort is a list of {path, order} maps, order being asc or desc
+// tenant and consistencyLevel are applied to the query
+func (c *Client) buildGetQuery(className string, query map[string]interface{}) (*graphql.GetBuilder, error) {
+	getter := c.client.GraphQL().Get().WithClassName(className)
+
+	if nearVector, ok := query["nearVector"].(map[string]interface{}); ok {
+		builder, err := buildNearVector(nearVector)
+		if err != nil {
+			return nil, err
+		}
+		getter = getter.WithNearVector(builder)
+	}
+
+	if nearText, ok := query["nearText"].(map[string]interface{}); ok {
+		builder, err := buildNearText(nearText)
+		if err != nil {
+			return nil, err
+		}
+		getter = getter.WithNearText(builder)
+	}
+
+	if hybrid, ok := query["hybrid"].(map[string]interface{}); ok {
+		builder, err := buildHybrid(hybrid)
+		if err != nil {
+			return nil, err
+		}
+		getter = getter.WithHybrid(builder)
+	}
+
+	if bm25, ok := query["bm25"].(map[string]interface{}); ok {
+		queryString, ok := bm25["query"].(string)
+		if !ok {
+			return nil, fmt.Errorf("bm25 requires a query string")
+		}
+		builder := (&graphql.BM25ArgumentBuilder{}).WithQuery(queryString)
+		if properties := GetStringSlice(bm25["properties"]); len(properties) > 0 {
+			builder = builder.WithProperties(properties...)
+		}
+		getter = getter.WithBM25(builder)
+	}
+
+	if whereFilter, ok := query["where"].(map[string]interface{}); ok {
+		where, err := buildWhereFilter(whereFilter)
+		if err != nil {
+			return nil, err
+		}
+		getter = getter.WithWhere(where)
+	}
+
+	if limitVal, exists := query["limit"]; exists {
+		if limit, ok := ToInt(limitVal); ok {
+			getter = getter.WithLimit(limit)
+		}
+	}
+
+	if offsetVal, exists := query["offset"]; exists {
+		if offset, ok := ToInt(offsetVal); ok {
+			getter = getter.WithOffset(offset)
+		}
+	}
+
+	if sortVal, exists := query["sort"]; exists {
+		sort, err := buildSort(sortVal)
+		if err != nil {
+			return nil, err
+		}
+		getter = getter.WithSort(sort...)
+	}
+
+	if autoCutVal, exists := query["autoCut"]; exists {
+		autoCut, ok := ToInt(autoCutVal)
+		if !ok {
+			return nil, fmt.Errorf("autoCut must be an integer")
+		}
+		getter = getter.WithAutocut(autoCut)
+	}
+
+	if tenant := c.tenantOption(query); tenant != "" {
+		getter = getter.WithTenant(tenant)
+	}
+
+	consistencyLevel, err := c.consistencyLevelOption(query)
+	if err != nil {
+		return nil, err
+	}
+	if consistencyLevel != "" {
+		getter = getter.WithConsistencyLevel(consistencyLevel)
+	}
+
+	return getter, nil
+}
+
+func buildSort(value interface{}) ([]graphql.Sort, error) {
+	clauses, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("sort must be an array of {path, order} objects")
+	}
+
+	sort := make([]graphql.Sort, len(clauses))
+	for i, clause := range clauses {
+		clauseMap, ok := clause.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("sort at index %d must be an object", i)
+		}
+		path := GetStringSlice(clauseMap["path"])
+		if property, ok := clauseMap["path"].(string); ok {
+			path = []string{property}
+		}
+		if len(path) == 0 {
+			return nil, fmt.Errorf("sort at index %d requires a path", i)
+		}
+		sort[i] = graphql.Sort{Path: path}
+
+		if order, ok := clauseMap["order"].(string); ok {
+			switch graphql.SortOrder(order) {
+			case graphql.Asc, graphql.Desc:
+				sort[i].Order = graphql.SortOrder(order)
+			default:
+				return nil, fmt.Errorf("invalid sort order at index %d: %s (valid options: asc, desc)", i, order)
+			}
+		}
+	}
+	return sort, nil
+}
+
+func buildNearVector(nearVector map[string]interface{}) (*graphql.NearVectorArgumentBuilder, error) {
+	builder := &graphql.NearVectorArgumentBuilder{}
+
+	if vector, ok := ToFloat32Slice(nearVector["vector"]); ok {
+		builder = builder.WithVector(vector)
+	} else if _, ok := ToFloat32Matrix(nearVector["vector"]); ok {
+		return nil, fmt.Errorf("multi-vector (2D) query vectors are not supported by GraphQL nearVector")
+	} else {
+		return nil, fmt.Errorf("nearVector requires a numeric vector")
+	}
+
+	certainty, distance, err := searchThreshold("nearVector", nearVector)
+	if err != nil {
+		return nil, err
+	}
+	if certainty != nil {
+		builder = builder.WithCertainty(*certainty)
+	}
+	if distance != nil {
+		builder = builder.WithDistance(*distance)
+	}
+	targets, err := buildTargets(nearVector)
+	if err != nil {
+		return nil, err
+	}
+	if targets != nil {
+		// targets already names the target vectors
+		builder = builder.WithTargets(targets)
+	} else if targetVectors := GetStringSlice(nearVector["targetVectors"]); len(targetVectors) > 0 {
+		builder = builder.WithTargetVectors(targetVectors...)
+	}
+
+	return builder, nil
+}
+
+// searchThreshold reads the certainty or distance cutoff of a vector search,
+// at most one of which may be set
+func searchThreshold(name string, search map[string]interface{}) (certainty, distance *float32, err error) {
+	_, hasCertainty := search["certainty"]
+	_, hasDistance := search["distance"]
+	if hasCertainty && hasDistance {
+		return nil, nil, fmt.Errorf("%s: specify either certainty or distance, not both", name)
+	}
+
+	if hasCertainty {
+		value, ok := ToFloat64(search["certainty"])
+		if !ok {
+			return nil, nil, fmt.Errorf("%s: certainty must be a number", name)
+		}
+		c := float32(value)
+		certainty = &c
+	}
+	if hasDistance {
+		value, ok := ToFloat64(search["distance"])
+		if !ok {
+			return nil, nil, fmt.Errorf("%s: distance must be a number", name)
+		}
+		d := float32(value)
+		distance = &d
+	}
+	return certainty, distance, nil
+}
+
+// buildTargets builds the multi-target argument of a search from weights, a
+// map of target vector name to weight, and combinationMethod. Weights are
+// combined with manualWeights unless combinationMethod is relativeScore.
+// Without weights, combinationMethod sum, average or minimum applies to
+// targetVectors. It returns nil when no combination is requested.
+func buildTargets(search map[string]interface{}) (*graphql.MultiTargetArgumentBuilder, error) {
+	combinationMethod := GetStringValue(search, "combinationMethod")
+
+	if weightsVal, exists := search["weights"]; exists {
+		weightsMap, ok := weightsVal.(map[string]interface{})
+		if !ok || len(weightsMap) == 0 {
+			return nil, fmt.Errorf("weights must be a map of target vector name to weight")
+		}
+		weights := make(map[string]float32, len(weightsMap))
+		for name, w := range weightsMap {
+			weight, ok := ToFloat64(w)
+			if !ok {
+				return nil, fmt.Errorf("weight of target vector %s must be a number", name)
+			}
+			weights[name] = float32(weight)
+		}
+
+		switch combinationMethod {
+		case "", "manualWeights":
+			return (&graphql.MultiTargetArgumentBuilder{}).ManualWeights(weights), nil
+		case "relativeScore":
+			return (&graphql.MultiTargetArgumentBuilder{}).RelativeScore(weights), nil
+		default:
+			return nil, fmt.Errorf("invalid combinationMethod with weights: %s (valid options: manualWeights, relativeScore)", combinationMethod)
+		}
+	}
+
+	if combinationMethod == "" {
+		return nil, nil
+	}
+	targetVectors := GetStringSlice(search["targetVectors"])
+	if len(targetVectors) == 0 {
+		return nil, fmt.Errorf("combinationMethod %s requires targetVectors", combinationMethod)
+	}
+	switch combinationMethod {
+	case "sum":
+		return (&graphql.MultiTargetArgumentBuilder{}).Sum(targetVectors...), nil
+	case "average":
+		return (&graphql.MultiTargetArgumentBuilder{}).Average(targetVectors...), nil
+	case "minimum":
+		return (&graphql.MultiTargetArgumentBuilder{}).Minimum(targetVectors...), nil
+	default:
+		return nil, fmt.Errorf("invalid combinationMethod: %s (valid options: sum, average, minimum, manualWeights, relativeScore)", combinationMethod)
+	}
+}
+
+func buildNearText(nearText map[string]interface{}) (*graphql.NearTextArgumentBuilder, error) {
+	concepts := GetStringSlice(nearText["concepts"])
+	if concept, ok := nearText["concepts"].(string); ok {
+		concepts = []string{concept}
+	}
+	if len(concepts) == 0 {
+		return nil, fmt.Errorf("nearText requires concepts")
+	}
+
+	builder := (&graphql.NearTextArgumentBuilder{}).WithConcepts(concepts)
+	certainty, distance, err := searchThreshold("nearText", nearText)
+	if err != nil {
+		return nil, err
+	}
+	if certainty != nil {
+		builder = builder.WithCertainty(*certainty)
+	}
+	if distance != nil {
+		builder = builder.WithDistance(*distance)
+	}
+	if targetVectors := GetStringSlice(nearText["targetVectors"]); len(targetVectors) > 0 {
+		builder = builder.WithTargetVectors(targetVectors...)
+	}
+	if moveTo, exists := nearText["moveTo"]; exists {
+		parameters, err := buildMoveParameters("moveTo", moveTo)
+		if err != nil {
+			return nil, err
+		}
+		builder = builder.WithMoveTo(parameters)
+	}
+	if moveAwayFrom, exists := nearText["moveAwayFrom"]; exists {
+		parameters, err := buildMoveParameters("moveAwayFrom", moveAwayFrom)
+		if err != nil {
+			return nil, err
+		}
+		builder = builder.WithMoveAwayFrom(parameters)
+	}
+
+	return builder, nil
+}
+
+// buildMoveParameters reads a nearText move from a {concepts, objects, force}
+// map, objects being a list of {id, beacon} maps
+func buildMoveParameters(name string, value interface{}) (*graphql.MoveParameters, error) {
+	move, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("nearText.%s must be an object", name)
+	}
+
+	force, ok := ToFloat64(move["force"])
+	if !ok {
+		return nil, fmt.Errorf("nearText.%s requires a numeric force", name)
+	}
+	parameters := &graphql.MoveParameters{
+		Concepts: GetStringSlice(move["concepts"]),
+		Force:    float32(force),
+	}
+
+	if objects, exists := move["objects"]; exists {
+		list, ok := objects.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("nearText.%s.objects must be an array", name)
+		}
+		for i, item := range list {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("nearText.%s.objects at index %d must be an object", name, i)
+			}
+			parameters.Objects = append(parameters.Objects, graphql.MoverObject{
+				ID:     GetStringValue(object, "id"),
+				Beacon: GetStringValue(object, "beacon"),
+			})
+		}
+	}
+
+	if len(parameters.Concepts) == 0 && len(parameters.Objects) == 0 {
+		return nil, fmt.Errorf("nearText.%s requires concepts or objects", name)
+	}
+	return parameters, nil
+}
+
+func buildHybrid(hybrid map[string]interface{}) (*graphql.HybridArgumentBuilder, error) {
+	queryString, ok := hybrid["query"].(string)
+	if !ok {
+		return nil, fmt.Errorf("hybrid requires a query string")
+	}
+
+	builder := (&graphql.HybridArgumentBuilder{}).WithQuery(queryString)
+	if alpha, ok := ToFloat64(hybrid["alpha"]); ok {
+		builder = builder.WithAlpha(float32(alpha))
+	}
+	if vector, ok := ToFloat32Slice(hybrid["vector"]); ok {
+		builder = builder.WithVector(vector)
+	}
+	if properties := GetStringSlice(hybrid["properties"]); len(properties) > 0 {
+		builder = builder.WithProperties(properties)
+	}
+	if fusionType, ok := hybrid["fusionType"].(string); ok {
+		switch fusionType {
+		case "ranked", string(graphql.Ranked):
+			builder = builder.WithFusionType(graphql.Ranked)
+		case "relativeScore", string(graphql.RelativeScore):
+			builder = builder.WithFusionType(graphql.RelativeScore)
+		default:
+			return nil, fmt.Errorf("invalid fusionType: %s", fusionType)
+		}
+	}
+
+	return builder, nil
+}
+
+// buildFields returns the fields selected by a query map: the properties
+// listed in fields, plus _additional with id, the additional list and the
+// rerank score when rerank is set. See buildPropertyFields for fields.
+func buildFields(query map[string]interface{}) ([]graphql.Field, error) {
+	fields, err := buildPropertyFields(query["fields"])
+	if err != nil {
+		return nil, err
+	}
+
+	additional := []graphql.Field{{Name: "id"}}
+	for _, name := range GetStringSlice(query["additional"]) {
+		if name != "id" {
+			additional = append(additional, graphql.Field{Name: name})
+		}
+	}
+
+	if rerank, ok := query["rerank"].(map[string]interface{}); ok {
+		field, err := buildRerankField(rerank)
+		if err != nil {
+			return nil, err
+		}
+		additional = append(additional, field)
+	}
+
+	return append(fields, graphql.Field{Name: "_additional", Fields: additional}), nil
+}
+
+// buildPropertyFields converts a list of fields into GraphQL fields. Each
+// entry is a property name, or a {name, collection, fields} map selecting
+// the fields of the objects a cross-reference property points to in the
+// given collection, or list of collections, always including their id.
+// fields of a reference may nest further references.
+func buildPropertyFields(value interface{}) ([]graphql.Field, error) {
+	var entries []interface{}
+	switch v := value.(type) {
+	case nil:
+	case []string:
+		for _, name := range v {
+			entries = append(entries, name)
+		}
+	case []interface{}:
+		entries = v
+	default:
+		return nil, fmt.Errorf("fields must be a list of property names and references")
+	}
+
+	fields := make([]graphql.Field, 0, len(entries))
+	for i, entry := range entries {
+		switch e := entry.(type) {
+		case string:
+			fields = append(fields, graphql.Field{Name: e})
+		case map[string]interface{}:
+			name := GetStringValue(e, "name")
+			collections, ok := referenceCollections(e["collection"])
+			if name == "" || !ok {
+				return nil, fmt.Errorf("reference field at index %d requires a name and a collection", i)
+			}
+			targetFields, err := buildPropertyFields(e["fields"])
+			if err != nil {
+				return nil, fmt.Errorf("reference field %s: %w", name, err)
+			}
+			targetFields = append(targetFields, graphql.Field{Name: "_additional", Fields: []graphql.Field{{Name: "id"}}})
+			fragments := make([]graphql.Field, len(collections))
+			for j, collection := range collections {
+				fragments[j] = graphql.Field{Name: "... on " + graphQLClassName(collection), Fields: targetFields}
+			}
+			fields = append(fields, graphql.Field{Name: name, Fields: fragments})
+		default:
+			return nil, fmt.Errorf("field at index %d must be a property name or a reference", i)
+		}
+	}
+	return fields, nil
+}
+
+// referenceCollections reads the collection of a reference field, a name or
+// a non-empty list of names
+func referenceCollections(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, v != ""
+	case []string:
+		return v, len(v) > 0
+	case []interface{}:
+		collections := make([]string, len(v))
+		for i, entry := range v {
+			name, ok := entry.(string)
+			if !ok || name == "" {
+				return nil, false
+			}
+			collections[i] = name
+		}
+		return collections, len(collections) > 0
+	}
+	return nil, false
+}
+
+func buildGroupBy(groupBy map[string]interface{}) (*graphql.GroupByArgumentBuilder, error) {
+	path := GetStringSlice(groupBy["path"])
+	if property, ok := groupBy["path"].(string); ok {
+		path = []string{property}
+	}
+	if len(path) == 0 {
+		return nil, fmt.Errorf("groupBy requires a path")
+	}
+	groups, ok := ToInt(groupBy["groups"])
+	if !ok {
+		return nil, fmt.Errorf("groupBy requires a number of groups")
+	}
+	objectsPerGroup, ok := ToInt(groupBy["objectsPerGroup"])
+	if !ok {
+		return nil, fmt.Errorf("groupBy requires a number of objectsPerGroup")
+	}
+
+	return (&graphql.GroupByArgumentBuilder{}).
+		WithPath(path).
+		WithGroups(groups).
+		WithObjectsPerGroup(objectsPerGroup), nil
+}
+
+// groupByFields nests the selected fields inside the group hits, which is
+// where grouped queries return the objects
+func groupByFields(hitFields []graphql.Field) []graphql.Field {
+	return []graphql.Field{{
+		Name: "_additional",
+		Fields: []graphql.Field{{
+			Name: "group",
+			Fields: []graphql.Field{
+				{Name: "id"},
+				{Name: "groupedBy", Fields: []graphql.Field{{Name: "value"}, {Name: "path"}}},
+				{Name: "count"},
+				{Name: "maxDistance"},
+				{Name: "minDistance"},
+				{Name: "hits", Fields: hitFields},
+			},
+		}},
+	}}
+}
+
+// buildRerankField builds the rerank additional field from a {property, query}
+// map. The reranker module scores each result against query using the value
+// of property; query defaults to the search query when omitted.
+func buildRerankField(rerank map[string]interface{}) (graphql.Field, error) {
+	property := GetStringValue(rerank, "property")
+	if property == "" {
+		return graphql.Field{}, fmt.Errorf("rerank requires a property")
+	}
+
+	args := fmt.Sprintf("property:%s", quoteGraphQL(property))
+	if rerankQuery := GetStringValue(rerank, "query"); rerankQuery != "" {
+		args += fmt.Sprintf(" query:%s", quoteGraphQL(rerankQuery))
+	}
+
+	return graphql.Field{
+		Name:   fmt.Sprintf("rerank(%s)", args),
+		Fields: []graphql.Field{{Name: "score"}},
+	}, nil
+}
+
+// buildGenerateField builds the generate additional field of a query
+// selecting the model. The go-client GenerativeSearchBuilder, used otherwise,
+// has no way to select it, so the field is assembled here using the dynamic
+// RAG syntax (e.g. openai: {model: "..."}).
+func buildGenerateField(singleResultPrompt, groupedResultTask string, groupedProperties []string, generateOptions map[string]interface{}) (graphql.Field, error) {
+	providerArg := ""
+	if model := GetStringValue(generateOptions, "model"); model != "" {
+		provider := GetStringValue(generateOptions, "provider")
+		if provider == "" {
+			return graphql.Field{}, fmt.Errorf("generate.provider is required when generate.model is set")
+		}
+		providerArg = fmt.Sprintf(" %s:{model:%s}", provider, quoteGraphQL(model))
+	}
+
+	args := make([]string, 0, 2)
+	resultFields := make([]graphql.Field, 0, 3)
+	if singleResultPrompt != "" {
+		args = append(args, fmt.Sprintf("singleResult:{prompt:%s%s}", quoteGraphQL(singleResultPrompt), providerArg))
+		resultFields = append(resultFields, graphql.Field{Name: "singleResult"})
+	}
+	if groupedResultTask != "" {
+		groupedArgs := fmt.Sprintf("task:%s", quoteGraphQL(groupedResultTask))
+		if len(groupedProperties) > 0 {
+			properties, _ := json.Marshal(groupedProperties)
+			groupedArgs += fmt.Sprintf(" properties:%s", properties)
+		}
+		args = append(args, fmt.Sprintf("groupedResult:{%s%s}", groupedArgs, providerArg))
+		resultFields = append(resultFields, graphql.Field{Name: "groupedResult"})
+	}
+	resultFields = append(resultFields, graphql.Field{Name: "error"})
+
+	return graphql.Field{
+		Name:   fmt.Sprintf("generate(%s)", strings.Join(args, " ")),
+		Fields: resultFields,
+	}, nil
+}
+
+// quoteGraphQL quotes a string as a GraphQL string literal
+func quoteGraphQL(s string) string {
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
+
+// graphQLError joins the errors of a GraphQL response
+func graphQLError(errors []*models.GraphQLError) error {
+	messages := make([]string, len(errors))
+	for i, e := range errors {
+		messages[i] = e.Message
+	}
+	return fmt.Errorf("graphql error: %s", strings.Join(messages, "; "))
+}
+
+// getResults returns the raw results of a Get response for a class
+func getResults(response *models.GraphQLResponse, className string) ([]interface{}, error) {
+	if len(response.Errors) > 0 {
+		return nil, graphQLError(response.Errors)
+	}
+
+	get, ok := response.Data["Get"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected graphql response: missing Get")
+	}
+	// GraphQL class names always start with an uppercase letter
+	results, _ := get[graphQLClassName(className)].([]interface{})
+	return results, nil
+}
+
+// parseGetResponse converts the objects of a Get response into maps with id,
+// properties and additional keys
+func parseGetResponse(response *models.GraphQLResponse, className string) ([]map[string]interface{}, error) {
+	rawObjects, err := getResults(response, className)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]map[string]interface{}, 0, len(rawObjects))
+	for _, raw := range rawObjects {
+		if obj, ok := raw.(map[string]interface{}); ok {
+			objects = append(objects, convertGraphQLObject(obj))
+		}
+	}
+	return objects, nil
+}
+
+// parseGroupByResponse converts the results of a grouped Get response into
+// maps with id, groupedBy, count, maxDistance, minDistance and objects keys
+func parseGroupByResponse(response *models.GraphQLResponse, className string) ([]map[string]interface{}, error) {
+	rawGroups, err := getResults(response, className)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]map[string]interface{}, 0, len(rawGroups))
+	for _, raw := range rawGroups {
+		obj, _ := raw.(map[string]interface{})
+		additional, _ := obj["_additional"].(map[string]interface{})
+		group, ok := additional["group"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		hits, _ := group["hits"].([]interface{})
+		objects := make([]map[string]interface{}, 0, len(hits))
+		for _, hit := range hits {
+			if hitObj, ok := hit.(map[string]interface{}); ok {
+				objects = append(objects, convertGraphQLObject(hitObj))
+			}
+		}
+
+		groupedBy, _ := group["groupedBy"].(map[string]interface{})
+		groups = append(groups, map[string]interface{}{
+			"id":          group["id"],
+			"groupedBy":   groupedBy["value"],
+			"count":       group["count"],
+			"maxDistance": group["maxDistance"],
+			"minDistance": group["minDistance"],
+			"objects":     objects,
+		})
+	}
+	return groups, nil
+}
+
+func convertGraphQLObject(obj map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{}, len(obj))
+	item := map[string]interface{}{}
+	for key, value := range obj {
+		if key == "_additional" {
+			if additional, ok := value.(map[string]interface{}); ok {
+				if id, ok := additional["id"].(string); ok {
+					item["id"] = id
+				}
+				item["additional"] = additional
+			}
+			continue
+		}
+		properties[key] = value
+	}
+	item["properties"] = properties
+	return item
+}
+
+func graphQLClassName(className string) string {
+	if className == "" {
+		return className
+	}
+	return strings.ToUpper(className[:1]) + className[1:]
+}
@@ -0,0 +1,189 @@
+package weaviate
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/db"
+	grpcbatch "github.com/weaviate/weaviate-go-client/v4/weaviate/grpc/batch"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+// grpcTLSConfig builds the TLS config of the gRPC connection from the gRPC
+// options of a NewClient config, or returns nil when the go-client defaults
+// apply. The trust settings of the tls option apply to gRPC too, unless
+// overridden by:
+// grpcCACert is a PEM encoded CA bundle the gRPC server certificate must be
+// signed by
+// grpcSkipTLSVerify set to true connects over TLS without verifying the
+// server certificate, for self-signed certificates
+func grpcTLSConfig(cfg map[string]interface{}) (*tls.Config, error) {
+	tlsCfg, err := tlsConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if value, exists := cfg["grpcCACert"]; exists {
+		caCert, ok := value.(string)
+		if !ok || caCert == "" {
+			return nil, fmt.Errorf("grpcCACert must be a PEM encoded certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("grpcCACert contains no valid PEM certificates")
+		}
+		if tlsCfg == nil {
+			tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if value, exists := cfg["grpcSkipTLSVerify"]; exists {
+		skip, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("grpcSkipTLSVerify must be a boolean")
+		}
+		if skip {
+			if tlsCfg == nil {
+				tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			tlsCfg.InsecureSkipVerify = true
+		}
+	}
+	return tlsCfg, nil
+}
+
+// grpcKeepaliveParams returns the keepalive pings of the gRPC connections
+// set by the grpcKeepalive option of a NewClient config, or nil when it is
+// not set. grpcKeepalive is a map of:
+// timeMs, the idle time after which the connection is pinged
+// timeoutMs, how long to wait for the ping to be acknowledged before closing
+// the connection (default 20000)
+// Servers close connections pinging more often than they allow, which is
+// every 5 minutes by default.
+func grpcKeepaliveParams(cfg map[string]interface{}) (*keepalive.ClientParameters, error) {
+	value, exists := cfg["grpcKeepalive"]
+	if !exists {
+		return nil, nil
+	}
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("grpcKeepalive must be an object of timeMs and timeoutMs")
+	}
+
+	params := &keepalive.ClientParameters{}
+	for name, duration := range map[string]*time.Duration{"timeMs": &params.Time, "timeoutMs": &params.Timeout} {
+		value, exists := options[name]
+		if !exists {
+			continue
+		}
+		ms, ok := ToFloat64(value)
+		if !ok || ms <= 0 {
+			return nil, fmt.Errorf("grpcKeepalive.%s must be a positive number", name)
+		}
+		*duration = time.Duration(ms * float64(time.Millisecond))
+	}
+	if params.Time == 0 {
+		return nil, fmt.Errorf("grpcKeepalive requires timeMs")
+	}
+	return params, nil
+}
+
+// grpcBatchClient sends gRPC batches over a connection dialed by the
+// extension instead of the go-client, which cannot verify the server
+// certificate, set keepalives, spread batches over several hosts or close
+// its connection.
+type grpcBatchClient struct {
+	conn    *grpc.ClientConn
+	client  pb.WeaviateClient
+	batch   grpcbatch.Batch
+	headers map[string]string
+	timeout time.Duration
+}
+
+// newGRPCBatchClient dials host with tlsConfig, or in plaintext when it is
+// nil, and pings idle connections with keepaliveParams when set. The
+// connection is health checked when startupTimeout is set. The server
+// version, which decides how vectors are encoded, is read through client.
+func newGRPCBatchClient(host string, tlsConfig *tls.Config, keepaliveParams *keepalive.ClientParameters,
+	headers map[string]string, timeout, startupTimeout time.Duration, client *weaviate.Client,
+) (*grpcBatchClient, error) {
+	transportCredentials := insecure.NewCredentials()
+	if tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}
+	if keepaliveParams != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*keepaliveParams))
+	}
+	conn, err := grpc.NewClient(host, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+	if startupTimeout > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+		defer cancel()
+		if _, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{}); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to connect to gRPC host %s: %w", host, err)
+		}
+	}
+
+	versionProvider := db.NewVersionProvider(func() string {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+		defer cancel()
+		meta, err := client.Misc().MetaGetter().Do(ctx)
+		if err != nil {
+			return ""
+		}
+		return meta.Version
+	})
+	return &grpcBatchClient{
+		conn:    conn,
+		client:  pb.NewWeaviateClient(conn),
+		batch:   grpcbatch.New(db.NewGRPCVersionSupport(versionProvider)),
+		headers: headers,
+		timeout: timeout,
+	}, nil
+}
+
+// close closes the gRPC connection
+func (g *grpcBatchClient) close() error {
+	if err := g.conn.Close(); err != nil {
+		return fmt.Errorf("failed to close gRPC connection: %w", err)
+	}
+	return nil
+}
+
+// batchObjects sends objects in a single gRPC batch request, like the
+// go-client objects batcher
+func (g *grpcBatchClient) batchObjects(ctx context.Context, objects []*models.Object, consistencyLevel string) ([]models.ObjectsGetResponse, error) {
+	batchObjects, err := g.batch.GetBatchObjects(objects)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+	if len(g.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(g.headers))
+	}
+	reply, err := g.client.BatchObjects(ctx, &pb.BatchObjectsRequest{
+		Objects:          batchObjects,
+		ConsistencyLevel: g.batch.GetConsistencyLevel(consistencyLevel),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch objects: %w", err)
+	}
+	return g.batch.ParseReply(reply, objects), nil
+}
@@ -0,0 +1,197 @@
+package weaviate
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Strategies picking the host of a request when a client has several
+const (
+	hostStrategyRoundRobin = "roundRobin"
+	hostStrategyRandom     = "random"
+	hostStrategyPerVU      = "perVU"
+)
+
+// Defaults of the health-aware host rotation
+const (
+	defaultMaxHostFailures = 3
+	defaultHostCooldown    = 10 * time.Second
+)
+
+// pinnedHosts hands out the hosts of perVU clients in turn. Every VU creates
+// its own client, so this spreads the VUs over the hosts.
+var pinnedHosts atomic.Uint64
+
+// hostIndexKey is the context key of the host index a request is sent to
+type hostIndexKey struct{}
+
+// hostPool spreads the requests of a client over the nodes of a cluster.
+// A host failing maxFailures requests in a row is skipped for cooldown.
+type hostPool struct {
+	hosts       []string
+	strategy    string
+	pinned      int
+	next        atomic.Uint64
+	maxFailures int
+	cooldown    time.Duration
+
+	mu        sync.Mutex
+	failures  []int
+	downUntil []time.Time
+}
+
+// newHostPool creates the pool of hosts from the load balancing options of
+// a NewClient config:
+// strategy is roundRobin, random or perVU, which pins the client of each VU
+// to one host (default roundRobin)
+// maxHostFailures is the number of consecutive failures after which a host
+// is skipped (default 3)
+// hostCooldownSeconds is how long a failing host is skipped (default 10)
+func newHostPool(hosts []string, cfg map[string]interface{}) (*hostPool, error) {
+	pool := &hostPool{
+		hosts:       hosts,
+		strategy:    hostStrategyRoundRobin,
+		maxFailures: defaultMaxHostFailures,
+		cooldown:    defaultHostCooldown,
+		failures:    make([]int, len(hosts)),
+		downUntil:   make([]time.Time, len(hosts)),
+	}
+	if value, exists := cfg["strategy"]; exists {
+		strategy, _ := value.(string)
+		switch strategy {
+		case hostStrategyRoundRobin, hostStrategyRandom:
+		case hostStrategyPerVU:
+			pool.pinned = int((pinnedHosts.Add(1) - 1) % uint64(len(hosts)))
+		default:
+			return nil, fmt.Errorf("invalid strategy: %v (valid options: roundRobin, random, perVU)", value)
+		}
+		pool.strategy = strategy
+	}
+	if value, exists := cfg["maxHostFailures"]; exists {
+		failures, ok := ToInt(value)
+		if !ok || failures <= 0 {
+			return nil, fmt.Errorf("maxHostFailures must be a positive integer")
+		}
+		pool.maxFailures = failures
+	}
+	if value, exists := cfg["hostCooldownSeconds"]; exists {
+		seconds, ok := ToFloat64(value)
+		if !ok || seconds <= 0 {
+			return nil, fmt.Errorf("hostCooldownSeconds must be a positive number")
+		}
+		pool.cooldown = time.Duration(seconds * float64(time.Second))
+	}
+	return pool, nil
+}
+
+// pick returns the index of the host of the next operation. Hosts in their
+// cooldown are passed over, unless every host is.
+func (p *hostPool) pick() int {
+	var first int
+	switch p.strategy {
+	case hostStrategyRandom:
+		first = rand.IntN(len(p.hosts))
+	case hostStrategyPerVU:
+		first = p.pinned
+	default:
+		first = int((p.next.Add(1) - 1) % uint64(len(p.hosts)))
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	for offset := range p.hosts {
+		i := (first + offset) % len(p.hosts)
+		if !now.Before(p.downUntil[i]) {
+			return i
+		}
+	}
+	return first
+}
+
+// report records the outcome of a request to a host
+func (p *hostPool) report(i int, failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !failed {
+		p.failures[i] = 0
+		return
+	}
+	p.failures[i]++
+	if p.failures[i] >= p.maxFailures {
+		p.failures[i] = 0
+		p.downUntil[i] = time.Now().Add(p.cooldown)
+	}
+}
+
+// withHost returns ctx bound to host i of the pool
+func withHost(ctx context.Context, i int) context.Context {
+	return context.WithValue(ctx, hostIndexKey{}, i)
+}
+
+// hostIndex returns the host index ctx is bound to
+func hostIndex(ctx context.Context) (int, bool) {
+	i, ok := ctx.Value(hostIndexKey{}).(int)
+	return i, ok
+}
+
+// servedBy returns the host the requests of ctx are sent to, or an empty
+// string when the client has a single host
+func (c *Client) servedBy(ctx context.Context) string {
+	if c.hosts == nil {
+		return ""
+	}
+	i, _ := hostIndex(ctx)
+	return c.hosts.hosts[i]
+}
+
+// withServedBy sets the host key of an operation result when the client has
+// several hosts
+func (c *Client) withServedBy(ctx context.Context, result map[string]interface{}) map[string]interface{} {
+	if host := c.servedBy(ctx); host != "" {
+		result["host"] = host
+	}
+	return result
+}
+
+// balancingTransport sends the requests addressed to the first host of a
+// pool to the host their context is bound to, or to the next host of the
+// pool. Other requests, e.g. to an identity provider, pass through.
+type balancingTransport struct {
+	base http.RoundTripper
+	pool *hostPool
+}
+
+func (t *balancingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.pool.hosts[0] {
+		return t.base.RoundTrip(req)
+	}
+	i, ok := hostIndex(req.Context())
+	if !ok {
+		i = t.pool.pick()
+	}
+	if i != 0 {
+		req = req.Clone(req.Context())
+		req.URL.Host = t.pool.hosts[i]
+		req.Host = t.pool.hosts[i]
+	}
+
+	response, err := t.base.RoundTrip(req)
+	if err != nil && req.Context().Err() != nil {
+		// cancelled or timed out by the caller, the host is not to blame
+		return response, err
+	}
+	t.pool.report(i, err != nil || isUnavailableStatus(response.StatusCode))
+	return response, err
+}
+
+// isUnavailableStatus reports whether a status code means the node cannot
+// serve requests, as opposed to rejecting one
+func isUnavailableStatus(code int) bool {
+	return code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
+}
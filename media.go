@@ -0,0 +1,251 @@
+package weaviate
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/graphql"
+)
+
+// nearMediaOptions holds the arguments shared by all near-media searches
+type nearMediaOptions struct {
+	media         string
+	certainty     *float32
+	distance      *float32
+	targetVectors []string
+}
+
+// GraphQLNearImage searches objects close to an image.
+// image is the base64 encoded image, an http(s) URL or a file path, or an
+// object of exactly one of base64, file and url to pick the source
+// explicitly. Files and URLs are read once and cached for the rest of the
+// test.
+// certainty and distance are the optional thresholds
+// targetVectors restricts the search to the given named vectors
+// the remaining keys (limit, fields, additional, where, ...) are the same as
+// for GraphQLGet
+func (c *Client) GraphQLNearImage(className string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	return c.nearMediaSearch(className, query, "image", nearMediaArguments["image"])
+}
+
+// GraphQLNearAudio searches objects close to an audio clip passed as media, in
+// the forms of the image of GraphQLNearImage, with the same other keys
+func (c *Client) GraphQLNearAudio(className string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	return c.nearMediaSearch(className, query, "media", nearMediaArguments["audio"])
+}
+
+// GraphQLNearVideo searches objects close to a video passed as media, in the
+// forms of the image of GraphQLNearImage, with the same other keys
+func (c *Client) GraphQLNearVideo(className string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	return c.nearMediaSearch(className, query, "media", nearMediaArguments["video"])
+}
+
+// GraphQLNearDepth searches objects close to a depth image passed as media, in
+// the forms of the image of GraphQLNearImage, with the same other keys
+func (c *Client) GraphQLNearDepth(className string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	return c.nearMediaSearch(className, query, "media", nearMediaArguments["depth"])
+}
+
+// GraphQLNearIMU searches objects close to an IMU reading passed as media, in
+// the forms of the image of GraphQLNearImage, with the same other keys
+func (c *Client) GraphQLNearIMU(className string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	return c.nearMediaSearch(className, query, "media", nearMediaArguments["imu"])
+}
+
+// GraphQLNearThermal searches objects close to a thermal image passed as media,
+// in the forms of the image of GraphQLNearImage, with the same other keys
+func (c *Client) GraphQLNearThermal(className string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	return c.nearMediaSearch(className, query, "media", nearMediaArguments["thermal"])
+}
+
+// nearMediaArguments adds the near-media argument of each media type to a
+// Get query
+var nearMediaArguments = map[string]func(*graphql.GetBuilder, nearMediaOptions) *graphql.GetBuilder{
+	"image": func(getter *graphql.GetBuilder, opts nearMediaOptions) *graphql.GetBuilder {
+		return getter.WithNearImage(withNearMediaOptions((&graphql.NearImageArgumentBuilder{}).WithImage(opts.media), opts))
+	},
+	"audio": func(getter *graphql.GetBuilder, opts nearMediaOptions) *graphql.GetBuilder {
+		return getter.WithNearAudio(withNearMediaOptions((&graphql.NearAudioArgumentBuilder{}).WithAudio(opts.media), opts))
+	},
+	"video": func(getter *graphql.GetBuilder, opts nearMediaOptions) *graphql.GetBuilder {
+		return getter.WithNearVideo(withNearMediaOptions((&graphql.NearVideoArgumentBuilder{}).WithVideo(opts.media), opts))
+	},
+	"depth": func(getter *graphql.GetBuilder, opts nearMediaOptions) *graphql.GetBuilder {
+		return getter.WithNearDepth(withNearMediaOptions((&graphql.NearDepthArgumentBuilder{}).WithDepth(opts.media), opts))
+	},
+	"imu": func(getter *graphql.GetBuilder, opts nearMediaOptions) *graphql.GetBuilder {
+		return getter.WithNearImu(withNearMediaOptions((&graphql.NearImuArgumentBuilder{}).WithImu(opts.media), opts))
+	},
+	"thermal": func(getter *graphql.GetBuilder, opts nearMediaOptions) *graphql.GetBuilder {
+		return getter.WithNearThermal(withNearMediaOptions((&graphql.NearThermalArgumentBuilder{}).WithThermal(opts.media), opts))
+	},
+}
+
+// nearMediaBuilder is implemented by the near-media argument builders of the
+// go-client
+type nearMediaBuilder[B any] interface {
+	WithCertainty(certainty float32) B
+	WithDistance(distance float32) B
+	WithTargetVectors(targetVectors ...string) B
+}
+
+// withNearMediaOptions sets the thresholds and target vectors shared by all
+// near-media arguments
+func withNearMediaOptions[B nearMediaBuilder[B]](builder B, opts nearMediaOptions) B {
+	if opts.certainty != nil {
+		builder = builder.WithCertainty(*opts.certainty)
+	}
+	if opts.distance != nil {
+		builder = builder.WithDistance(*opts.distance)
+	}
+	if len(opts.targetVectors) > 0 {
+		builder = builder.WithTargetVectors(opts.targetVectors...)
+	}
+	return builder
+}
+
+// nearMediaSearch runs a Get query with the near-media argument added by
+// withMedia, reading the media from the mediaKey of the query map
+func (c *Client) nearMediaSearch(className string, query map[string]interface{}, mediaKey string,
+	withMedia func(*graphql.GetBuilder, nearMediaOptions) *graphql.GetBuilder,
+) ([]map[string]interface{}, error) {
+	source, exists := query[mediaKey]
+	if !exists || source == "" {
+		return nil, fmt.Errorf("%s is required", mediaKey)
+	}
+	media, err := c.loadMedia(mediaKey, source, query)
+	if err != nil {
+		return nil, err
+	}
+
+	certainty, distance, err := searchThreshold(mediaKey+" search", query)
+	if err != nil {
+		return nil, err
+	}
+	opts := nearMediaOptions{
+		media:         media,
+		certainty:     certainty,
+		distance:      distance,
+		targetVectors: GetStringSlice(query["targetVectors"]),
+	}
+
+	getter, err := c.buildGetQuery(className, query)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := buildFields(query)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.requestContext(query)
+	defer cancel()
+	response, err := withMedia(getter, opts).WithFields(fields...).Do(ctx)
+	if err != nil {
+		return nil, requestError(ctx, err)
+	}
+	return parseGetResponse(response, className)
+}
+
+// mediaCache holds the base64 content of the media files and URLs read by
+// the near-media searches, so that every query after the first one measures
+// the search alone. The media of a test are few, they are kept for its run.
+var mediaCache sync.Map
+
+// loadMedia returns the base64 content of the media source of key. A string
+// is fetched when it is an http(s) URL, read when it names a file and sent as
+// base64 otherwise. An object of exactly one of base64, file and url picks
+// the source explicitly. URLs are fetched through the client connections
+// within the request timeout of options.
+func (c *Client) loadMedia(key string, source interface{}, options map[string]interface{}) (string, error) {
+	var kind, location string
+	if value, ok := source.(string); ok {
+		kind, location = mediaSourceKind(value), value
+	} else {
+		sources, ok := source.(map[string]interface{})
+		if !ok || len(sources) != 1 {
+			return "", fmt.Errorf("%s must be a base64 string, a file path, a URL or an object of one of base64, file and url", key)
+		}
+		for k, v := range sources {
+			kind = k
+			location, ok = v.(string)
+			if !ok || location == "" {
+				return "", fmt.Errorf("%s.%s must be a non-empty string", key, k)
+			}
+		}
+	}
+	switch kind {
+	case "base64":
+		return location, nil
+	case "file", "url":
+	default:
+		return "", fmt.Errorf("invalid %s source: %s (valid options: base64, file, url)", key, kind)
+	}
+
+	cacheKey := kind + ":" + location
+	if cached, ok := mediaCache.Load(cacheKey); ok {
+		return cached.(string), nil
+	}
+	var data []byte
+	var err error
+	if kind == "file" {
+		data, err = os.ReadFile(location)
+		if err != nil {
+			return "", fmt.Errorf("failed to read media %s: %w", location, err)
+		}
+	} else {
+		data, err = c.fetchMedia(location, options)
+		if err != nil {
+			return "", err
+		}
+	}
+	encoded := base64.StdEncoding.EncodeToString(data)
+	mediaCache.Store(cacheKey, encoded)
+	return encoded, nil
+}
+
+// mediaSourceKind tells the source of a media string: url for an http(s)
+// URL, file for the path of a regular file and base64 otherwise
+func mediaSourceKind(value string) string {
+	lower := strings.ToLower(value)
+	if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") {
+		return "url"
+	}
+	if info, err := os.Stat(value); err == nil && info.Mode().IsRegular() {
+		return "file"
+	}
+	return "base64"
+}
+
+// fetchMedia downloads media from an http(s) URL
+func (c *Client) fetchMedia(mediaURL string, options map[string]interface{}) ([]byte, error) {
+	lower := strings.ToLower(mediaURL)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return nil, fmt.Errorf("media url must be an http(s) URL: %s", mediaURL)
+	}
+
+	ctx, cancel := c.requestContext(options)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mediaURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media url %s: %w", mediaURL, err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch media %s: %w", mediaURL, requestError(ctx, err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch media %s: status code %d", mediaURL, resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media %s: %w", mediaURL, requestError(ctx, err))
+	}
+	return data, nil
+}
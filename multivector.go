@@ -0,0 +1,219 @@
+package weaviate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ToFloat32Slice converts a JS number array or typed array into a float32
+// vector
+func ToFloat32Slice(val interface{}) ([]float32, bool) {
+	switch v := val.(type) {
+	case []float32:
+		return v, true
+	case []float64:
+		vector := make([]float32, len(v))
+		for i, f := range v {
+			vector[i] = float32(f)
+		}
+		return vector, true
+	case []interface{}:
+		vector := make([]float32, len(v))
+		for i, item := range v {
+			f, ok := ToFloat64(item)
+			if !ok {
+				return nil, false
+			}
+			vector[i] = float32(f)
+		}
+		return vector, true
+	default:
+		// Typed arrays such as Int32Array export as slices of their element type
+		rv := reflect.ValueOf(val)
+		if rv.Kind() != reflect.Slice {
+			return nil, false
+		}
+		vector := make([]float32, rv.Len())
+		for i := range vector {
+			f, ok := ToFloat64(rv.Index(i).Interface())
+			if !ok {
+				return nil, false
+			}
+			vector[i] = float32(f)
+		}
+		return vector, true
+	}
+}
+
+// ToFloat32Matrix converts a JS array of number arrays into a multi-vector
+// (one vector per token, as used by ColBERT style embeddings)
+func ToFloat32Matrix(val interface{}) ([][]float32, bool) {
+	switch v := val.(type) {
+	case [][]float32:
+		return v, true
+	case [][]float64:
+		matrix := make([][]float32, len(v))
+		for i, row := range v {
+			matrix[i], _ = ToFloat32Slice(row)
+		}
+		return matrix, true
+	case []interface{}:
+		if len(v) == 0 {
+			return nil, false
+		}
+		matrix := make([][]float32, len(v))
+		for i, row := range v {
+			vector, ok := ToFloat32Slice(row)
+			if !ok {
+				return nil, false
+			}
+			matrix[i] = vector
+		}
+		return matrix, true
+	default:
+		return nil, false
+	}
+}
+
+// normalizeMultiVectorConfig validates the multi-vector settings of a vector
+// index config, accepting both multiVector and multivector as the key
+func normalizeMultiVectorConfig(value interface{}) (map[string]interface{}, error) {
+	settings, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("vectorIndexConfig.multiVector must be an object")
+	}
+
+	multiVector := make(map[string]interface{}, len(settings))
+	for name, setting := range settings {
+		switch name {
+		case "enabled":
+			b, ok := setting.(bool)
+			if !ok {
+				return nil, fmt.Errorf("vectorIndexConfig.multiVector.enabled must be a boolean")
+			}
+			multiVector[name] = b
+		case "aggregation":
+			aggregation, ok := setting.(string)
+			if !ok || aggregation != "maxSim" {
+				return nil, fmt.Errorf("invalid multiVector aggregation: %v (valid options: maxSim)", setting)
+			}
+			multiVector[name] = aggregation
+		case "muvera":
+			muvera, ok := setting.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("vectorIndexConfig.multiVector.muvera must be an object")
+			}
+			multiVector[name] = muvera
+		default:
+			return nil, fmt.Errorf("unknown multiVector option %q (valid options: aggregation, enabled, muvera)", name)
+		}
+	}
+
+	return multiVector, nil
+}
+
+// splitVectors separates regular named vectors (number arrays) from
+// multi-vectors (arrays of number arrays), based on the shape of each value
+func splitVectors(vectors map[string]interface{}) (models.Vectors, map[string][][]float32, error) {
+	namedVectors := make(models.Vectors, len(vectors))
+	var multiVectors map[string][][]float32
+	for name, vec := range vectors {
+		if vector, ok := ToFloat32Slice(vec); ok {
+			namedVectors[name] = vector
+		} else if matrix, ok := ToFloat32Matrix(vec); ok {
+			if multiVectors == nil {
+				multiVectors = make(map[string][][]float32)
+			}
+			multiVectors[name] = matrix
+		} else {
+			return nil, nil, fmt.Errorf("vector %s must be an array of numbers or an array of number arrays", name)
+		}
+	}
+	return namedVectors, multiVectors, nil
+}
+
+// multiVectorObject overrides the vectors of an object with a representation
+// that can hold multi-vectors, which models.Vectors cannot express
+type multiVectorObject struct {
+	*models.Object
+	Vectors map[string]interface{} `json:"vectors,omitempty"`
+}
+
+func newMultiVectorObject(obj *models.Object, multiVectors map[string][][]float32) *multiVectorObject {
+	vectors := make(map[string]interface{}, len(obj.Vectors)+len(multiVectors))
+	for name, vector := range obj.Vectors {
+		vectors[name] = vector
+	}
+	for name, matrix := range multiVectors {
+		vectors[name] = matrix
+	}
+	return &multiVectorObject{Object: obj, Vectors: vectors}
+}
+
+// multiVectorObjectResponse is the subset of an object response we read back
+// when vectors may be multi-vectors
+type multiVectorObjectResponse struct {
+	ID         strfmt.UUID                         `json:"id"`
+	Class      string                              `json:"class"`
+	Properties map[string]interface{}              `json:"properties"`
+	Vector     []float32                           `json:"vector"`
+	Vectors    map[string]interface{}              `json:"vectors"`
+	Tenant     string                              `json:"tenant"`
+	Result     *models.ObjectsGetResponseAO2Result `json:"result"`
+}
+
+// insertMultiVectorObject inserts a single object holding multi-vectors
+// through the REST API
+func (c *Client) insertMultiVectorObject(ctx context.Context, obj *models.Object, multiVectors map[string][][]float32, consistencyLevel string) (*multiVectorObjectResponse, error) {
+	path := "/objects"
+	if consistencyLevel != "" {
+		path += "?" + url.Values{"consistency_level": {consistencyLevel}}.Encode()
+	}
+
+	var response multiVectorObjectResponse
+	if err := c.runRESTContext(ctx, http.MethodPost, path, newMultiVectorObject(obj, multiVectors), &response, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// batchObjectsREST sends a batch through the REST API, returning results in
+// the shape of the go-client batcher. multiVectors may be nil.
+func (c *Client) batchObjectsREST(ctx context.Context, objects []*models.Object, multiVectors []map[string][][]float32, consistencyLevel string) ([]models.ObjectsGetResponse, error) {
+	body := make([]interface{}, len(objects))
+	for i, obj := range objects {
+		if multiVectors != nil && multiVectors[i] != nil {
+			body[i] = newMultiVectorObject(obj, multiVectors[i])
+		} else {
+			body[i] = obj
+		}
+	}
+
+	path := "/batch/objects"
+	if consistencyLevel != "" {
+		path += "?" + url.Values{"consistency_level": {consistencyLevel}}.Encode()
+	}
+
+	var parsed []multiVectorObjectResponse
+	if err := c.runRESTContext(ctx, http.MethodPost, path, map[string]interface{}{
+		"fields":  []string{"ALL"},
+		"objects": body,
+	}, &parsed, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	results := make([]models.ObjectsGetResponse, len(parsed))
+	for i, p := range parsed {
+		results[i] = models.ObjectsGetResponse{
+			Object: models.Object{Class: p.Class, ID: p.ID, Tenant: p.Tenant},
+			Result: p.Result,
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,124 @@
+package weaviate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// quantizerOption describes the expected type of a quantizer setting
+type quantizerOption int
+
+const (
+	quantizerBool quantizerOption = iota
+	quantizerInt
+	quantizerMap
+)
+
+// quantizerOptions lists the settings accepted by every supported quantizer
+var quantizerOptions = map[string]map[string]quantizerOption{
+	"pq": {
+		"enabled":        quantizerBool,
+		"segments":       quantizerInt,
+		"centroids":      quantizerInt,
+		"trainingLimit":  quantizerInt,
+		"bitCompression": quantizerBool,
+		"rescoreLimit":   quantizerInt,
+		"encoder":        quantizerMap,
+	},
+	"bq": {
+		"enabled":      quantizerBool,
+		"rescoreLimit": quantizerInt,
+		"cache":        quantizerBool,
+	},
+	"sq": {
+		"enabled":       quantizerBool,
+		"trainingLimit": quantizerInt,
+		"rescoreLimit":  quantizerInt,
+		"cache":         quantizerBool,
+	},
+	"rq": {
+		"enabled":      quantizerBool,
+		"bits":         quantizerInt,
+		"rescoreLimit": quantizerInt,
+	},
+}
+
+// NormalizeVectorIndexConfig validates the quantizer settings (pq, bq, sq, rq)
+// of a vector index config and coerces their numeric values coming from JS.
+// Other vector index settings are passed through untouched.
+func NormalizeVectorIndexConfig(vectorIndexConfig map[string]interface{}) (map[string]interface{}, error) {
+	normalized := make(map[string]interface{}, len(vectorIndexConfig))
+	enabled := make([]string, 0, 1)
+
+	for key, value := range vectorIndexConfig {
+		if key == "multiVector" || key == "multivector" {
+			multiVector, err := normalizeMultiVectorConfig(value)
+			if err != nil {
+				return nil, err
+			}
+			normalized["multivector"] = multiVector
+			continue
+		}
+
+		options, isQuantizer := quantizerOptions[key]
+		if !isQuantizer {
+			normalized[key] = value
+			continue
+		}
+
+		settings, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("vectorIndexConfig.%s must be an object", key)
+		}
+
+		quantizer := make(map[string]interface{}, len(settings))
+		for name, setting := range settings {
+			option, ok := options[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown %s option %q (valid options: %s)", key, name, joinOptionNames(options))
+			}
+			switch option {
+			case quantizerBool:
+				b, ok := setting.(bool)
+				if !ok {
+					return nil, fmt.Errorf("vectorIndexConfig.%s.%s must be a boolean", key, name)
+				}
+				quantizer[name] = b
+			case quantizerInt:
+				n, ok := ToInt(setting)
+				if !ok {
+					return nil, fmt.Errorf("vectorIndexConfig.%s.%s must be a number", key, name)
+				}
+				quantizer[name] = n
+			case quantizerMap:
+				m, ok := setting.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("vectorIndexConfig.%s.%s must be an object", key, name)
+				}
+				quantizer[name] = m
+			}
+		}
+
+		if GetBoolValue(quantizer, "enabled", false) {
+			enabled = append(enabled, key)
+		}
+		normalized[key] = quantizer
+	}
+
+	if len(enabled) > 1 {
+		sort.Strings(enabled)
+		return nil, fmt.Errorf("only one quantizer can be enabled, got: %s", strings.Join(enabled, ", "))
+	}
+
+	return normalized, nil
+}
+
+func joinOptionNames(options map[string]quantizerOption) string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
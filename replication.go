@@ -0,0 +1,129 @@
+package weaviate
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Replication operation states the polling helper acts on, other states are
+// reported as is
+const (
+	replicationStateReady     = "READY"
+	replicationStateCancelled = "CANCELLED"
+)
+
+// replicationTypes are the replica movement types, a copy keeps the source
+// replica and a move removes it
+var replicationTypes = map[string]bool{
+	"COPY": true,
+	"MOVE": true,
+}
+
+// ReplicateShard starts copying or moving a shard replica between nodes and
+// returns the id of the replication operation. Requires Weaviate 1.32.
+// operation is a map of:
+// collection, shard, sourceNode and targetNode, all required
+// type is COPY or MOVE (default COPY)
+func (c *Client) ReplicateShard(operation map[string]interface{}) (string, error) {
+	body := make(map[string]interface{}, 5)
+	for _, key := range []string{"collection", "shard", "sourceNode", "targetNode"} {
+		value := GetStringValue(operation, key)
+		if value == "" {
+			return "", fmt.Errorf("%s is required", key)
+		}
+		body[key] = value
+	}
+
+	replicationType := strings.ToUpper(GetStringValue(operation, "type"))
+	if replicationType == "" {
+		replicationType = "COPY"
+	}
+	if !replicationTypes[replicationType] {
+		return "", fmt.Errorf("invalid replication type: %s (valid options: COPY, MOVE)", operation["type"])
+	}
+	body["type"] = replicationType
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := c.runREST(http.MethodPost, "/replication/replicate", body, &response, http.StatusOK); err != nil {
+		return "", err
+	}
+	return response.ID, nil
+}
+
+// GetReplicationOperation returns a replication operation as reported by the
+// server, its state under status.state
+func (c *Client) GetReplicationOperation(id string) (map[string]interface{}, error) {
+	var operation map[string]interface{}
+	err := c.runREST(http.MethodGet, "/replication/replicate/"+url.PathEscape(id), nil, &operation, http.StatusOK)
+	if isNotFound(err) {
+		return nil, &NotFoundError{Resource: "replication operation", Name: id}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+// ListReplicationOperations lists replication operations. filters is an
+// optional map of collection, shard and targetNode, and includeHistory to
+// return the past states of every operation.
+func (c *Client) ListReplicationOperations(filters map[string]interface{}) ([]map[string]interface{}, error) {
+	query := url.Values{}
+	for _, key := range []string{"collection", "shard", "targetNode"} {
+		if value := GetStringValue(filters, key); value != "" {
+			query.Set(key, value)
+		}
+	}
+	if GetBoolValue(filters, "includeHistory", false) {
+		query.Set("includeHistory", "true")
+	}
+
+	path := "/replication/replicate/list"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	var operations []map[string]interface{}
+	if err := c.runREST(http.MethodGet, path, nil, &operations, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+// CancelReplicationOperation cancels a replication operation that is not
+// finished yet
+func (c *Client) CancelReplicationOperation(id string) error {
+	return c.runREST(http.MethodPost, "/replication/replicate/"+url.PathEscape(id)+"/cancel", nil, nil, http.StatusNoContent)
+}
+
+// WaitForReplicationOperation waits until a replication operation is READY
+// and returns the time waited in milliseconds. A cancelled operation is an
+// error. timeoutMs defaults to 60000.
+func (c *Client) WaitForReplicationOperation(id string, timeoutMs int) (int64, error) {
+	timeout := defaultWaitTimeout
+	if timeoutMs > 0 {
+		timeout = time.Duration(timeoutMs) * time.Millisecond
+	}
+
+	waited, err := pollUntil("replication operation "+id, timeout, defaultWaitPollInterval, 0, func() ([]string, error) {
+		operation, err := c.GetReplicationOperation(id)
+		if err != nil {
+			return nil, err
+		}
+		status, _ := operation["status"].(map[string]interface{})
+		state, _ := status["state"].(string)
+		switch state {
+		case replicationStateReady:
+			return nil, nil
+		case replicationStateCancelled:
+			return nil, fmt.Errorf("replication operation %s was cancelled", id)
+		default:
+			return []string{"state " + state}, nil
+		}
+	})
+	return waited.Milliseconds(), err
+}
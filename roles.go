@@ -0,0 +1,152 @@
+package weaviate
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// userTypes are the kinds of users roles can be assigned to, db users are
+// managed by Weaviate and oidc users by an identity provider
+var userTypes = map[string]bool{
+	"db":   true,
+	"oidc": true,
+}
+
+// roleAssignment mirrors the body of the role assign and revoke requests
+type roleAssignment struct {
+	Roles    []string `json:"roles"`
+	UserType string   `json:"userType"`
+}
+
+// normalizeUserType validates a user type, an empty one defaults to db
+func normalizeUserType(userType string) (string, error) {
+	if userType == "" {
+		return "db", nil
+	}
+	normalized := strings.ToLower(userType)
+	if !userTypes[normalized] {
+		return "", fmt.Errorf("invalid user type: %s (valid options: db, oidc)", userType)
+	}
+	return normalized, nil
+}
+
+// AssignRoles assigns roles to a user. userType is db or oidc (default db).
+// Requires RBAC to be enabled.
+func (c *Client) AssignRoles(userID string, roles []string, userType string) error {
+	return c.updateUserRoles("assign", userID, roles, userType)
+}
+
+// RevokeRoles revokes roles from a user. userType is db or oidc (default db).
+func (c *Client) RevokeRoles(userID string, roles []string, userType string) error {
+	return c.updateUserRoles("revoke", userID, roles, userType)
+}
+
+func (c *Client) updateUserRoles(action, userID string, roles []string, userType string) error {
+	if len(roles) == 0 {
+		return fmt.Errorf("at least one role is required")
+	}
+	userType, err := normalizeUserType(userType)
+	if err != nil {
+		return err
+	}
+	path := "/authz/users/" + url.PathEscape(userID) + "/" + action
+	return c.runREST(http.MethodPost, path, roleAssignment{Roles: roles, UserType: userType}, nil, http.StatusOK)
+}
+
+// GetRolesForUser returns the names of the roles assigned to a user.
+// userType is db or oidc (default db).
+func (c *Client) GetRolesForUser(userID string, userType string) ([]string, error) {
+	userType, err := normalizeUserType(userType)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []struct {
+		Name string `json:"name"`
+	}
+	path := "/authz/users/" + url.PathEscape(userID) + "/roles/" + userType
+	if err := c.runREST(http.MethodGet, path, nil, &roles, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.Name
+	}
+	return names, nil
+}
+
+// GetMyUser returns the user the client is authenticated as, as {userId,
+// roles}, with the active status of db users as active
+func (c *Client) GetMyUser() (map[string]interface{}, error) {
+	userID, roles, err := c.ownInfo()
+	if err != nil {
+		return nil, err
+	}
+	user := map[string]interface{}{
+		"userId": userID,
+		"roles":  roles,
+	}
+
+	// only db users have an active status, other users are not found and
+	// anonymous users have no id
+	path, err := dbUserPath(userID)
+	if err != nil {
+		return user, nil
+	}
+	var dbUser struct {
+		Active bool `json:"active"`
+	}
+	err = c.runREST(http.MethodGet, path, nil, &dbUser, http.StatusOK)
+	switch {
+	case err == nil:
+		user["active"] = dbUser.Active
+	case !isNotFound(err):
+		return nil, err
+	}
+	return user, nil
+}
+
+// HasPermission reports whether a role of the current user grants
+// permission, an RBAC permission such as {action: "read_data", data:
+// {collection: "*"}}
+func (c *Client) HasPermission(permission map[string]interface{}) (bool, error) {
+	if GetStringValue(permission, "action") == "" {
+		return false, fmt.Errorf("permission requires an action")
+	}
+	_, roles, err := c.ownInfo()
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		var granted bool
+		path := "/authz/roles/" + url.PathEscape(role) + "/has-permission"
+		if err := c.runREST(http.MethodPost, path, permission, &granted, http.StatusOK); err != nil {
+			return false, err
+		}
+		if granted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ownInfo returns the id and role names of the current user
+func (c *Client) ownInfo() (string, []string, error) {
+	var info struct {
+		Username string `json:"username"`
+		Roles    []struct {
+			Name string `json:"name"`
+		} `json:"roles"`
+	}
+	if err := c.runREST(http.MethodGet, "/users/own-info", nil, &info, http.StatusOK); err != nil {
+		return "", nil, err
+	}
+	roles := make([]string, len(info.Roles))
+	for i, role := range info.Roles {
+		roles[i] = role.Name
+	}
+	return info.Username, roles, nil
+}
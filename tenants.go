@@ -0,0 +1,210 @@
+package weaviate
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	defaultTenantBatchSize = 100
+	maxReportedErrors      = 5
+)
+
+// BulkCreateTenants creates a large number of tenants in chunks, so that no
+// single request exceeds the server size limit.
+// opts is a map of:
+// tenants is a list of tenant names or {name, activityStatus} maps
+// prefix and count generate count tenants named prefix0 to prefix<count-1>,
+// instead of passing tenants, with an optional activityStatus
+// batchSize is the number of tenants per request (default 100)
+// concurrency is the number of requests sent in parallel (default 1)
+// The result holds the tenants, chunks, createdChunks and failedChunks counts,
+// and the first errors encountered.
+func (c *Client) BulkCreateTenants(collectionName string, opts map[string]interface{}) (map[string]interface{}, error) {
+	tenants, err := bulkTenants(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := defaultTenantBatchSize
+	if size, ok := ToInt(opts["batchSize"]); ok && size > 0 {
+		batchSize = size
+	}
+	concurrency := 1
+	if n, ok := ToInt(opts["concurrency"]); ok && n > 0 {
+		concurrency = n
+	}
+
+	chunks := chunkTenants(tenants, batchSize)
+	errs := forEachConcurrently(len(chunks), concurrency, func(i int) error {
+		return c.createTenants(collectionName, chunks[i])
+	})
+
+	failed := 0
+	messages := make([]string, 0)
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed++
+		if len(messages) < maxReportedErrors {
+			messages = append(messages, fmt.Sprintf("chunk %d: %v", i, err))
+		}
+	}
+
+	return map[string]interface{}{
+		"tenants":       len(tenants),
+		"chunks":        len(chunks),
+		"createdChunks": len(chunks) - failed,
+		"failedChunks":  failed,
+		"errors":        messages,
+	}, nil
+}
+
+// bulkTenants reads the tenants to create from either the tenants list or
+// the prefix and count shorthand
+func bulkTenants(opts map[string]interface{}) ([]map[string]interface{}, error) {
+	if countVal, exists := opts["count"]; exists {
+		count, ok := ToInt(countVal)
+		if !ok || count < 0 {
+			return nil, fmt.Errorf("count must be a non-negative integer")
+		}
+		prefix := GetStringValue(opts, "prefix")
+		status := GetStringValue(opts, "activityStatus")
+		if status != "" {
+			if _, err := NormalizeTenantStatus(status); err != nil {
+				return nil, err
+			}
+		}
+		tenants := make([]map[string]interface{}, count)
+		for i := range tenants {
+			tenants[i] = map[string]interface{}{
+				"name":           fmt.Sprintf("%s%d", prefix, i),
+				"activityStatus": status,
+			}
+		}
+		return tenants, nil
+	}
+
+	list, ok := opts["tenants"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("tenants or count is required")
+	}
+	tenants := make([]map[string]interface{}, len(list))
+	for i, item := range list {
+		switch t := item.(type) {
+		case string:
+			tenants[i] = map[string]interface{}{"name": t}
+		case map[string]interface{}:
+			tenants[i] = t
+		default:
+			return nil, fmt.Errorf("tenant at index %d must be a name or an object", i)
+		}
+	}
+	return tenants, nil
+}
+
+func chunkTenants(tenants []map[string]interface{}, size int) [][]map[string]interface{} {
+	chunks := make([][]map[string]interface{}, 0, (len(tenants)+size-1)/size)
+	for start := 0; start < len(tenants); start += size {
+		end := start + size
+		if end > len(tenants) {
+			end = len(tenants)
+		}
+		chunks = append(chunks, tenants[start:end])
+	}
+	return chunks
+}
+
+// createTenants creates tenants in a single request
+func (c *Client) createTenants(collectionName string, tenants []map[string]interface{}) error {
+	modelTenants, err := buildTenants(tenants)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	return requestError(ctx, c.client.Schema().
+		TenantsCreator().
+		WithClassName(collectionName).
+		WithTenants(modelTenants...).
+		Do(ctx))
+}
+
+// GetTenant returns the name and activityStatus of a single tenant, without
+// listing all tenants of the collection. A missing tenant returns a
+// *NotFoundError.
+func (c *Client) GetTenant(collectionName string, tenantName string) (map[string]interface{}, error) {
+	var tenant models.Tenant
+	path := fmt.Sprintf("/schema/%s/tenants/%s", url.PathEscape(collectionName), url.PathEscape(tenantName))
+	if err := c.runREST(http.MethodGet, path, nil, &tenant, http.StatusOK); err != nil {
+		if isNotFound(err) {
+			return nil, &NotFoundError{Resource: "tenant", Name: collectionName + "/" + tenantName}
+		}
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"name":           tenant.Name,
+		"activityStatus": currentTenantStatus(tenant.ActivityStatus),
+	}, nil
+}
+
+// BatchCreateAcrossTenants distributes objects of a collection round-robin
+// across tenantNames and creates them in a single batch. Objects that
+// already have a tenant keep it. The result holds the per-object results
+// under results, and {success, failed} counts per tenant under tenants.
+func (c *Client) BatchCreateAcrossTenants(className string, objects []map[string]interface{}, tenantNames []string) (map[string]interface{}, error) {
+	if len(tenantNames) == 0 {
+		return nil, fmt.Errorf("at least one tenant name is required")
+	}
+
+	assigned := make([]map[string]interface{}, len(objects))
+	tenantOf := make([]string, len(objects))
+	for i, obj := range objects {
+		withTenant := make(map[string]interface{}, len(obj)+2)
+		for k, v := range obj {
+			withTenant[k] = v
+		}
+		if _, ok := withTenant["class"].(string); !ok {
+			withTenant["class"] = className
+		}
+		tenant, ok := withTenant["tenant"].(string)
+		if !ok || tenant == "" {
+			tenant = tenantNames[i%len(tenantNames)]
+			withTenant["tenant"] = tenant
+		}
+		assigned[i] = withTenant
+		tenantOf[i] = tenant
+	}
+
+	results, err := c.BatchCreate(assigned)
+	if err != nil {
+		return nil, err
+	}
+
+	// batch results are returned in the order of the objects
+	counts := make(map[string]interface{}, len(tenantNames))
+	perTenant := make(map[string]map[string]int, len(tenantNames))
+	for i, result := range results {
+		tenant := tenantOf[i]
+		if perTenant[tenant] == nil {
+			perTenant[tenant] = map[string]int{"success": 0, "failed": 0}
+			counts[tenant] = perTenant[tenant]
+		}
+		if result["status"] == "error" {
+			perTenant[tenant]["failed"]++
+		} else {
+			perTenant[tenant]["success"]++
+		}
+	}
+
+	return map[string]interface{}{
+		"results": results,
+		"tenants": counts,
+	}, nil
+}
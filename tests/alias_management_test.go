@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliasManagement(t *testing.T) {
+	client := createTestClient(t)
+	defer client.DeleteAllCollections()
+
+	t.Run("switch alias during inserts", func(t *testing.T) {
+		for _, name := range []string{"TestAliasBlue", "TestAliasGreen"} {
+			err := client.CreateCollection(name, map[string]interface{}{
+				"vectorizer": "none",
+			})
+			require.NoError(t, err)
+		}
+
+		err := client.CreateAlias("TestAliasLive", "TestAliasBlue")
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			if i == 5 {
+				err := client.UpdateAlias("TestAliasLive", "TestAliasGreen")
+				require.NoError(t, err)
+			}
+			_, err := client.ObjectInsert("TestAliasLive", map[string]interface{}{
+				"properties": map[string]interface{}{
+					"title": fmt.Sprintf("Document %d", i),
+				},
+			})
+			assert.NoError(t, err)
+		}
+
+		aliases, err := client.ListAliases()
+		require.NoError(t, err)
+		found := false
+		for _, alias := range aliases {
+			if alias["alias"] == "TestAliasLive" {
+				found = true
+				assert.Equal(t, "TestAliasGreen", alias["class"])
+			}
+		}
+		assert.True(t, found, "alias should be listed")
+
+		err = client.DeleteAlias("TestAliasLive")
+		assert.NoError(t, err)
+	})
+}
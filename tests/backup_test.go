@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackups(t *testing.T) {
+	var mu sync.Mutex
+	bodies := map[string]map[string]interface{}{}
+	backupRoute := func(response string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			bodies[r.URL.Path] = body
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(response))
+		}
+	}
+	lastBody := func(path string) map[string]interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return bodies[path]
+	}
+	server := newFakeServer(t, `{}`, map[string]http.HandlerFunc{
+		"/v1/backups/s3":                            backupRoute(`{"id": "backup-1", "backend": "s3", "path": "s3://bucket/backup-1", "status": "STARTED", "classes": ["Article"]}`),
+		"/v1/backups/s3/backup-1/restore":           backupRoute(`{"id": "backup-1", "backend": "s3", "path": "s3://bucket/backup-1", "status": "STARTED", "classes": ["Article"]}`),
+		"/v1/backups/filesystem":                    backupRoute(`{"id": "snapshot-1", "backend": "filesystem", "status": "STARTED", "classes": ["Article", "Author"]}`),
+		"/v1/backups/filesystem/snapshot-1/restore": backupRoute(`{"id": "snapshot-1", "backend": "filesystem", "status": "STARTED", "classes": ["Article", "Author"]}`),
+	})
+	client := server.client(t)
+
+	t.Run("create a backup", func(t *testing.T) {
+		result, err := client.BackupCreate("s3", "backup-1", map[string]interface{}{
+			"include": []interface{}{"Article"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "backup-1", result["id"])
+		assert.Equal(t, "STARTED", result["status"])
+		assert.Equal(t, []string{"Article"}, result["classes"])
+		assert.Equal(t, []interface{}{"Article"}, lastBody("/v1/backups/s3")["include"])
+	})
+
+	t.Run("restore a backup", func(t *testing.T) {
+		result, err := client.BackupRestore("s3", "backup-1", map[string]interface{}{
+			"exclude": []interface{}{"Author"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "s3://bucket/backup-1", result["path"])
+		assert.Equal(t, []interface{}{"Author"}, lastBody("/v1/backups/s3/backup-1/restore")["exclude"])
+	})
+
+	t.Run("snapshots", func(t *testing.T) {
+		result, err := client.CreateSnapshot("snapshot-1", []string{"Article", "Author"})
+		assert.NoError(t, err)
+		assert.Equal(t, "filesystem", result["backend"])
+		assert.Equal(t, []interface{}{"Article", "Author"}, lastBody("/v1/backups/filesystem")["include"])
+
+		result, err = client.RestoreSnapshot("snapshot-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "snapshot-1", result["id"])
+		assert.Nil(t, lastBody("/v1/backups/filesystem/snapshot-1/restore")["include"])
+	})
+
+	t.Run("invalid include and exclude", func(t *testing.T) {
+		_, err := client.BackupCreate("s3", "backup-1", map[string]interface{}{
+			"include": []interface{}{1},
+		})
+		assert.ErrorContains(t, err, "include must be a list of collection names")
+
+		_, err = client.BackupCreate("s3", "backup-1", map[string]interface{}{
+			"exclude": "Article",
+		})
+		assert.ErrorContains(t, err, "exclude must be a list of collection names")
+
+		_, err = client.BackupRestore("s3", "backup-1", map[string]interface{}{
+			"exclude": []interface{}{"Article", nil},
+		})
+		assert.ErrorContains(t, err, "exclude must be a list of collection names")
+	})
+}
+
+func TestBackupStatus(t *testing.T) {
+	statusRoute := func(status string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": "backup-1", "backend": "s3", "path": "s3://bucket/backup-1", "status": "` + status + `"}`))
+		}
+	}
+	server := newFakeServer(t, `{}`, map[string]http.HandlerFunc{
+		"/v1/backups/s3/backup-1":         statusRoute("SUCCESS"),
+		"/v1/backups/s3/backup-1/restore": statusRoute("TRANSFERRING"),
+		"/v1/backups/s3/running":          statusRoute("STARTED"),
+	})
+	client := server.client(t)
+
+	t.Run("create and restore status", func(t *testing.T) {
+		status, err := client.BackupCreateStatus("s3", "backup-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCESS", status["status"])
+		assert.Equal(t, "s3://bucket/backup-1", status["path"])
+
+		status, err = client.BackupRestoreStatus("s3", "backup-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "TRANSFERRING", status["status"])
+	})
+
+	t.Run("wait for a finished backup", func(t *testing.T) {
+		status, err := client.WaitForBackup("s3", "backup-1", "create", 1000)
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCESS", status["status"])
+		assert.Contains(t, status, "durationMs")
+	})
+
+	t.Run("wait times out", func(t *testing.T) {
+		_, err := client.WaitForBackup("s3", "running", "create", 100)
+		assert.ErrorContains(t, err, "backup running not ready after 100ms and 2 attempts, pending: status STARTED")
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		_, err := client.WaitForBackup("s3", "backup-1", "delete", 100)
+		assert.ErrorContains(t, err, "invalid backup kind: delete")
+	})
+}
@@ -1,10 +1,18 @@
 package tests
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/xk6-weaviate"
 )
 
 func TestBatchOperations(t *testing.T) {
@@ -50,6 +58,10 @@ func TestBatchOperations(t *testing.T) {
 			assert.Equal(t, "success", res["status"])
 		}
 
+		count, err := client.GetObjectsCount("TestBatch", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
 		// Batch delete objects
 		deleteResponse, err := client.BatchDelete("TestBatch", map[string]interface{}{
 			"where": map[string]interface{}{
@@ -76,4 +88,811 @@ func TestBatchOperations(t *testing.T) {
 		err = client.DeleteCollection("TestBatch")
 		assert.NoError(t, err)
 	})
+
+	t.Run("batch create with JS number array vectors", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchVectors", map[string]interface{}{
+			"vectorizer": "none",
+			"properties": []map[string]interface{}{
+				{
+					"name":     "title",
+					"dataType": []string{"text"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// goja hands JS arrays to Go as []interface{} of float64
+		vector := []interface{}{0.25, 0.5, 0.75}
+		createResults, err := client.BatchCreate([]map[string]interface{}{
+			{
+				"class":      "TestBatchVectors",
+				"id":         "00000000-0000-0000-0000-000000000001",
+				"properties": map[string]interface{}{"title": "Object 1"},
+				"vector":     vector,
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, createResults, 1)
+		assert.Equal(t, "success", createResults[0]["status"])
+
+		fetched, err := client.FetchObjects("TestBatchVectors", map[string]interface{}{
+			"id":         "00000000-0000-0000-0000-000000000001",
+			"additional": []interface{}{"vector"},
+		})
+		require.NoError(t, err)
+		objects, _ := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		stored, ok := objects[0]["vector"].(models.C11yVector)
+		require.True(t, ok, "vector should be returned")
+		require.Len(t, stored, len(vector))
+		for i, v := range vector {
+			assert.InDelta(t, v, float64(stored[i]), 1e-6)
+		}
+
+		err = client.DeleteCollection("TestBatchVectors")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create with JS array properties", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchArrays", map[string]interface{}{
+			"vectorizer": "none",
+			"properties": []map[string]interface{}{
+				{"name": "tags", "dataType": []string{"text[]"}},
+				{"name": "counts", "dataType": []string{"int[]"}},
+				{"name": "scores", "dataType": []string{"number[]"}},
+				{"name": "flags", "dataType": []string{"boolean[]"}},
+				{"name": "dates", "dataType": []string{"date[]"}},
+			},
+		})
+		require.NoError(t, err)
+
+		// goja hands JS arrays to Go as []interface{}, integers as int64
+		published := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		createResults, err := client.BatchCreate([]map[string]interface{}{
+			{
+				"class": "TestBatchArrays",
+				"id":    "00000000-0000-0000-0000-000000000001",
+				"properties": map[string]interface{}{
+					"tags":   []interface{}{"a", "b"},
+					"counts": []interface{}{int64(1), int64(2)},
+					"scores": []interface{}{int64(1), int64(2)},
+					"flags":  []interface{}{true, false},
+					"dates":  []interface{}{published, "2024-06-01T00:00:00Z"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, createResults, 1)
+		assert.Equal(t, "success", createResults[0]["status"], createResults[0]["error"])
+
+		fetched, err := client.FetchObjects("TestBatchArrays", map[string]interface{}{
+			"id": "00000000-0000-0000-0000-000000000001",
+		})
+		require.NoError(t, err)
+		objects, _ := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		properties, _ := objects[0]["properties"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"a", "b"}, properties["tags"])
+		assert.Len(t, properties["counts"], 2)
+		assert.Len(t, properties["scores"], 2)
+		assert.Equal(t, []interface{}{true, false}, properties["flags"])
+		assert.Len(t, properties["dates"], 2)
+
+		err = client.DeleteCollection("TestBatchArrays")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create with named vectors", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchNamedVectors", map[string]interface{}{
+			"properties": []map[string]interface{}{
+				{
+					"name":     "title",
+					"dataType": []string{"text"},
+				},
+			},
+			"vectorConfig": map[string]interface{}{
+				"title_vector": map[string]interface{}{
+					"vectorizer":      map[string]interface{}{"none": nil},
+					"vectorIndexType": "hnsw",
+				},
+				"body_vector": map[string]interface{}{
+					"vectorizer":      map[string]interface{}{"none": nil},
+					"vectorIndexType": "flat",
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		createResults, err := client.BatchCreate([]map[string]interface{}{
+			{
+				"class":      "TestBatchNamedVectors",
+				"id":         "00000000-0000-0000-0000-000000000002",
+				"properties": map[string]interface{}{"title": "Object 1"},
+				"vectors": map[string]interface{}{
+					"title_vector": []interface{}{0.1, 0.2, 0.3},
+					"body_vector":  []interface{}{0.4, 0.5, 0.6, 0.7},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, createResults, 1)
+		assert.Equal(t, "success", createResults[0]["status"])
+
+		fetched, err := client.FetchObjects("TestBatchNamedVectors", map[string]interface{}{
+			"id":         "00000000-0000-0000-0000-000000000002",
+			"additional": []interface{}{"vector"},
+		})
+		require.NoError(t, err)
+		objects, _ := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		vectors, _ := objects[0]["vectors"].(map[string]interface{})
+		assert.Len(t, vectors["title_vector"], 3)
+		assert.Len(t, vectors["body_vector"], 4)
+
+		err = client.DeleteCollection("TestBatchNamedVectors")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create in chunks", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchChunks", map[string]interface{}{
+			"vectorizer": "none",
+		})
+		require.NoError(t, err)
+
+		objects := make([]map[string]interface{}, 25)
+		for i := range objects {
+			objects[i] = map[string]interface{}{
+				"class":      "TestBatchChunks",
+				"properties": map[string]interface{}{"position": i},
+			}
+		}
+
+		result, err := client.BatchCreateWithOptions(objects, map[string]interface{}{
+			"batchSize": 10,
+		})
+		require.NoError(t, err)
+		assert.Len(t, result["results"], 25)
+
+		assert.Equal(t, 25, result["successful"])
+		assert.Equal(t, 0, result["failed"])
+		results, _ := result["results"].([]map[string]interface{})
+		for i, res := range results {
+			assert.Equal(t, i, res["index"])
+		}
+
+		chunks, _ := result["chunks"].([]map[string]interface{})
+		require.Len(t, chunks, 3)
+		assert.Equal(t, 10, chunks[0]["size"])
+		assert.Equal(t, 5, chunks[2]["size"])
+		for _, chunk := range chunks {
+			assert.Equal(t, 0, chunk["errors"])
+			assert.Contains(t, chunk, "durationMs")
+		}
+
+		err = client.DeleteCollection("TestBatchChunks")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create with concurrent workers", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchWorkers", map[string]interface{}{
+			"vectorizer": "none",
+		})
+		require.NoError(t, err)
+
+		objects := make([]map[string]interface{}, 100)
+		for i := range objects {
+			objects[i] = map[string]interface{}{
+				"class":      "TestBatchWorkers",
+				"id":         fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+				"properties": map[string]interface{}{"position": i},
+			}
+		}
+
+		result, err := client.BatchCreateWithOptions(objects, map[string]interface{}{
+			"batchSize":   10,
+			"concurrency": 4,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, result["workersUsed"])
+		assert.Equal(t, 100, result["successful"])
+
+		results, _ := result["results"].([]map[string]interface{})
+		require.Len(t, results, 100)
+		for i, res := range results {
+			assert.Equal(t, i, res["index"])
+			assert.Equal(t, objects[i]["id"], res["id"])
+		}
+
+		workers, _ := result["workers"].([]map[string]interface{})
+		require.Len(t, workers, 4)
+		total := 0
+		for _, worker := range workers {
+			total += worker["objects"].(int)
+			assert.Contains(t, worker, "objectsPerSecond")
+		}
+		assert.Equal(t, 100, total)
+
+		count, err := client.GetObjectsCount("TestBatchWorkers", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), count)
+
+		err = client.DeleteCollection("TestBatchWorkers")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create over grpc and rest", func(t *testing.T) {
+		counts := map[string]int64{}
+		for _, protocol := range []string{"grpc", "rest"} {
+			className := "TestBatchProtocol" + strings.ToUpper(protocol)
+			err := client.CreateCollection(className, map[string]interface{}{
+				"vectorizer": "none",
+			})
+			require.NoError(t, err)
+
+			objects := make([]map[string]interface{}, 200)
+			for i := range objects {
+				vector := make([]interface{}, 128)
+				for j := range vector {
+					vector[j] = float64(i+j) / 1000
+				}
+				objects[i] = map[string]interface{}{
+					"class":      className,
+					"properties": map[string]interface{}{"position": i},
+					"vector":     vector,
+				}
+			}
+
+			start := time.Now()
+			result, err := client.BatchCreateWithOptions(objects, map[string]interface{}{
+				"batchSize": 50,
+				"protocol":  protocol,
+			})
+			require.NoError(t, err)
+			t.Logf("%s: %d objects in %s", protocol, len(objects), time.Since(start))
+			assert.Equal(t, protocol, result["protocol"])
+			assert.Equal(t, 0, result["failed"])
+
+			count, err := client.GetObjectsCount(className, "")
+			require.NoError(t, err)
+			counts[protocol] = count
+
+			err = client.DeleteCollection(className)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int64(200), counts["grpc"])
+		assert.Equal(t, counts["grpc"], counts["rest"])
+	})
+
+	t.Run("reject invalid batch protocol", func(t *testing.T) {
+		_, err := client.BatchCreateWithOptions([]map[string]interface{}{
+			{"class": "TestBatchProtocol"},
+		}, map[string]interface{}{"protocol": "http"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid batch protocol")
+	})
+
+	t.Run("batch delete within geo range", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchGeo", map[string]interface{}{
+			"vectorizer": "none",
+			"properties": []interface{}{
+				map[string]interface{}{
+					"name":     "location",
+					"dataType": []interface{}{"geoCoordinates"},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		objects := []map[string]interface{}{
+			{
+				"class": "TestBatchGeo",
+				"properties": map[string]interface{}{
+					// Amsterdam
+					"location": map[string]interface{}{"latitude": 52.3676, "longitude": 4.9041},
+				},
+			},
+			{
+				"class": "TestBatchGeo",
+				"properties": map[string]interface{}{
+					// Berlin
+					"location": map[string]interface{}{"latitude": 52.5200, "longitude": 13.4050},
+				},
+			},
+		}
+		_, err = client.BatchCreate(objects)
+		require.NoError(t, err)
+
+		deleteResponse, err := client.BatchDelete("TestBatchGeo", map[string]interface{}{
+			"where": map[string]interface{}{
+				"operator": "WithinGeoRange",
+				"path":     []string{"location"},
+				"valueGeoRange": map[string]interface{}{
+					"latitude":  52.3702,
+					"longitude": 4.8952,
+					"distance":  10,
+					"unit":      "km",
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleteResponse["successful"])
+
+		err = client.DeleteCollection("TestBatchGeo")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch delete with nested filters", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchFilters", map[string]interface{}{
+			"vectorizer": "none",
+			"properties": []interface{}{
+				map[string]interface{}{"name": "position", "dataType": []interface{}{"int"}},
+				map[string]interface{}{"name": "tags", "dataType": []interface{}{"text[]"}},
+			},
+		})
+		require.NoError(t, err)
+
+		objects := make([]map[string]interface{}, 10)
+		for i := range objects {
+			tags := []interface{}{"even"}
+			if i%2 == 1 {
+				tags = []interface{}{"odd"}
+			}
+			if i%3 == 0 {
+				tags = append(tags, "triple")
+			}
+			objects[i] = map[string]interface{}{
+				"class":      "TestBatchFilters",
+				"properties": map[string]interface{}{"position": i, "tags": tags},
+			}
+		}
+		_, err = client.BatchCreate(objects)
+		require.NoError(t, err)
+
+		// (2 <= position < 8 and position != 4) or tags contain both odd and triple
+		deleteResponse, err := client.BatchDelete("TestBatchFilters", map[string]interface{}{
+			"where": map[string]interface{}{
+				"operator": "Or",
+				"operands": []interface{}{
+					map[string]interface{}{
+						"operator": "And",
+						"operands": []interface{}{
+							map[string]interface{}{"operator": "GreaterThanEqual", "path": []interface{}{"position"}, "valueInt": 2},
+							map[string]interface{}{"operator": "LessThan", "path": []interface{}{"position"}, "valueInt": 8},
+							map[string]interface{}{"operator": "NotEqual", "path": []interface{}{"position"}, "valueInt": 4},
+						},
+					},
+					map[string]interface{}{
+						"operator":  "ContainsAll",
+						"path":      []interface{}{"tags"},
+						"valueText": []interface{}{"odd", "triple"},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		// 2, 3, 5, 6, 7 and 9
+		assert.Equal(t, int64(6), deleteResponse["successful"])
+
+		count, err := client.GetObjectsCount("TestBatchFilters", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), count)
+
+		err = client.DeleteCollection("TestBatchFilters")
+		assert.NoError(t, err)
+	})
+
+	t.Run("reject unknown filter operator", func(t *testing.T) {
+		_, err := client.BatchDelete("TestBatchFilters", map[string]interface{}{
+			"where": map[string]interface{}{
+				"operator": "Or",
+				"operands": []interface{}{
+					map[string]interface{}{"operator": "GreaterThen", "path": []interface{}{"position"}, "valueInt": 2},
+				},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "operand at index 0: invalid where operator: GreaterThen")
+	})
+
+	t.Run("batch delete with typed filter values", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchTypedFilters", map[string]interface{}{
+			"vectorizer": "none",
+			"properties": []interface{}{
+				map[string]interface{}{"name": "position", "dataType": []interface{}{"int"}},
+				map[string]interface{}{"name": "score", "dataType": []interface{}{"number"}},
+				map[string]interface{}{"name": "archived", "dataType": []interface{}{"boolean"}},
+				map[string]interface{}{"name": "createdAt", "dataType": []interface{}{"date"}},
+			},
+		})
+		require.NoError(t, err)
+
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		objects := make([]map[string]interface{}, 10)
+		for i := range objects {
+			objects[i] = map[string]interface{}{
+				"class": "TestBatchTypedFilters",
+				"properties": map[string]interface{}{
+					"position":  float64(i),
+					"score":     float64(i) / 2,
+					"archived":  i%2 == 0,
+					"createdAt": base.AddDate(0, 0, i).Format(time.RFC3339),
+				},
+			}
+		}
+		_, err = client.BatchCreate(objects)
+		require.NoError(t, err)
+
+		matches := func(where map[string]interface{}) interface{} {
+			response, err := client.BatchDelete("TestBatchTypedFilters", map[string]interface{}{
+				"where":  where,
+				"dryRun": true,
+			})
+			require.NoError(t, err)
+			return response["matches"]
+		}
+
+		// JS numbers arrive as float64
+		assert.Equal(t, int64(3), matches(map[string]interface{}{
+			"operator": "GreaterThan", "path": []interface{}{"position"}, "valueInt": float64(6),
+		}))
+		assert.Equal(t, int64(4), matches(map[string]interface{}{
+			"operator": "LessThanEqual", "path": []interface{}{"score"}, "valueNumber": 1.5,
+		}))
+		assert.Equal(t, int64(5), matches(map[string]interface{}{
+			"operator": "Equal", "path": []interface{}{"archived"}, "valueBoolean": true,
+		}))
+		assert.Equal(t, int64(2), matches(map[string]interface{}{
+			"operator": "GreaterThanEqual", "path": []interface{}{"createdAt"}, "valueDate": "2024-01-09T00:00:00Z",
+		}))
+
+		_, err = client.BatchDelete("TestBatchTypedFilters", map[string]interface{}{
+			"where": map[string]interface{}{"operator": "Equal", "path": []interface{}{"position"}, "valueInt": 2.5},
+		})
+		assert.ErrorContains(t, err, "invalid valueInt: 2.5")
+
+		// delete the objects created in [2024-01-03, 2024-01-06), cutoffs in epoch millis
+		deleteResponse, err := client.BatchDelete("TestBatchTypedFilters", map[string]interface{}{
+			"where": map[string]interface{}{
+				"operator": "And",
+				"operands": []interface{}{
+					map[string]interface{}{
+						"operator":  "GreaterThanEqual",
+						"path":      []interface{}{"createdAt"},
+						"valueDate": float64(base.AddDate(0, 0, 2).UnixMilli()),
+					},
+					map[string]interface{}{
+						"operator":  "LessThan",
+						"path":      []interface{}{"createdAt"},
+						"valueDate": float64(base.AddDate(0, 0, 5).UnixMilli()),
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleteResponse["successful"])
+
+		count, err := client.GetObjectsCount("TestBatchTypedFilters", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), count)
+
+		err = client.DeleteCollection("TestBatchTypedFilters")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch delete by id and creation time", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchDeleteIDs", map[string]interface{}{
+			"vectorizer": "none",
+			"invertedIndexConfig": map[string]interface{}{
+				"indexTimestamps": true,
+			},
+		})
+		require.NoError(t, err)
+
+		newObjects := func(from, to int) []map[string]interface{} {
+			objects := make([]map[string]interface{}, 0, to-from)
+			for i := from; i < to; i++ {
+				objects = append(objects, map[string]interface{}{
+					"class":      "TestBatchDeleteIDs",
+					"id":         fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+					"properties": map[string]interface{}{"position": i},
+				})
+			}
+			return objects
+		}
+		_, err = client.BatchCreate(newObjects(0, 20))
+		require.NoError(t, err)
+
+		ids := make([]interface{}, 10)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("00000000-0000-0000-0000-%012d", i*2)
+		}
+		deleteResponse, err := client.BatchDelete("TestBatchDeleteIDs", map[string]interface{}{
+			"where": map[string]interface{}{
+				"operator":  "ContainsAny",
+				"path":      []interface{}{"_id"},
+				"valueText": ids,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), deleteResponse["successful"])
+
+		_, err = client.BatchDelete("TestBatchDeleteIDs", map[string]interface{}{
+			"where": map[string]interface{}{
+				"operator":  "ContainsAny",
+				"path":      []interface{}{"id"},
+				"valueText": []interface{}{"00000000-0000-0000-0000-000000000001", "not-a-uuid"},
+			},
+		})
+		assert.ErrorContains(t, err, "invalid UUID at index 1 of the id filter: not-a-uuid")
+
+		// the cutoff is read back from the server, whose clock sets the
+		// creation times, as the earliest creation time of the new objects
+		time.Sleep(10 * time.Millisecond)
+		_, err = client.BatchCreate(newObjects(20, 25))
+		require.NoError(t, err)
+		fetched, err := client.GraphQLGet("TestBatchDeleteIDs", map[string]interface{}{
+			"fields":     []interface{}{"position"},
+			"additional": []interface{}{"creationTimeUnix"},
+			"limit":      100,
+		})
+		require.NoError(t, err)
+		var cutoff int64
+		for _, obj := range fetched["objects"].([]map[string]interface{}) {
+			if position, _ := weaviate.ToInt(obj["properties"].(map[string]interface{})["position"]); position < 20 {
+				continue
+			}
+			created, err := strconv.ParseInt(obj["additional"].(map[string]interface{})["creationTimeUnix"].(string), 10, 64)
+			require.NoError(t, err)
+			if cutoff == 0 || created < cutoff {
+				cutoff = created
+			}
+		}
+		require.NotZero(t, cutoff)
+
+		deleteResponse, err = client.BatchDelete("TestBatchDeleteIDs", map[string]interface{}{
+			"where": map[string]interface{}{
+				"operator":  "LessThan",
+				"path":      []interface{}{"_creationTimeUnix"},
+				"valueDate": float64(cutoff),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), deleteResponse["successful"])
+
+		count, err := client.GetObjectsCount("TestBatchDeleteIDs", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), count)
+
+		err = client.DeleteCollection("TestBatchDeleteIDs")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create with multi-vectors", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchMultiVectors", map[string]interface{}{
+			"properties": []map[string]interface{}{
+				{"name": "tokens", "dataType": []string{"int"}},
+			},
+			"vectorConfig": map[string]interface{}{
+				"colbert": map[string]interface{}{
+					"vectorizer":      map[string]interface{}{"none": nil},
+					"vectorIndexType": "hnsw",
+					"vectorIndexConfig": map[string]interface{}{
+						"multiVector": map[string]interface{}{"enabled": true},
+					},
+				},
+				"dense": map[string]interface{}{
+					"vectorizer":      map[string]interface{}{"none": nil},
+					"vectorIndexType": "hnsw",
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// objects get 1 to 5 token vectors, every other object also has a dense vector
+		objects := make([]map[string]interface{}, 5)
+		for i := range objects {
+			tokens := make([]interface{}, i+1)
+			for j := range tokens {
+				tokens[j] = []interface{}{float64(j), 0.5, 1.0}
+			}
+			vectors := map[string]interface{}{"colbert": tokens}
+			if i%2 == 0 {
+				vectors["dense"] = []interface{}{0.1, 0.2, 0.3, 0.4}
+			}
+			objects[i] = map[string]interface{}{
+				"class":      "TestBatchMultiVectors",
+				"properties": map[string]interface{}{"tokens": i + 1},
+				"vectors":    vectors,
+			}
+		}
+		results, err := client.BatchCreate(objects)
+		require.NoError(t, err)
+		for _, res := range results {
+			assert.Equal(t, "success", res["status"], res["error"])
+		}
+
+		fetched, err := client.GraphQLGet("TestBatchMultiVectors", map[string]interface{}{
+			"fields":     []interface{}{"tokens"},
+			"additional": []interface{}{"vectors{colbert dense}"},
+		})
+		require.NoError(t, err)
+		stored, _ := fetched["objects"].([]map[string]interface{})
+		require.Len(t, stored, 5)
+		for _, obj := range stored {
+			properties, _ := obj["properties"].(map[string]interface{})
+			additional, _ := obj["additional"].(map[string]interface{})
+			vectors, _ := additional["vectors"].(map[string]interface{})
+			tokens, _ := properties["tokens"].(float64)
+			assert.Len(t, vectors["colbert"], int(tokens))
+			if int(tokens)%2 == 1 {
+				assert.Len(t, vectors["dense"], 4)
+			}
+		}
+
+		_, err = client.BatchCreate([]map[string]interface{}{{
+			"class":   "TestBatchMultiVectors",
+			"vectors": map[string]interface{}{"colbert": []interface{}{"not", "a", "vector"}},
+		}})
+		assert.ErrorContains(t, err, "object at index 0: vector colbert must be an array")
+
+		err = client.DeleteCollection("TestBatchMultiVectors")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create from a generator", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchStream", map[string]interface{}{
+			"vectorizer": "none",
+		})
+		require.NoError(t, err)
+
+		generator := func(i int) (map[string]interface{}, error) {
+			return map[string]interface{}{
+				"properties": map[string]interface{}{"position": i},
+				"vector":     []interface{}{float64(i), 1.0, 2.0},
+			}, nil
+		}
+		result, err := client.BatchCreateStream("TestBatchStream", 250, map[string]interface{}{
+			"batchSize": 100,
+		}, generator)
+		require.NoError(t, err)
+		assert.Equal(t, 250, result["sent"])
+		assert.Equal(t, 250, result["successful"])
+		assert.Equal(t, 0, result["failed"])
+		assert.Equal(t, 3, result["batches"])
+		assert.NotContains(t, result, "error")
+
+		count, err := client.GetObjectsCount("TestBatchStream", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(250), count)
+
+		// a generator error stops the stream, the full batches before it are sent
+		calls := 0
+		result, err = client.BatchCreateStream("TestBatchStream", 250, map[string]interface{}{
+			"batchSize": 100,
+		}, func(i int) (map[string]interface{}, error) {
+			calls++
+			if i == 150 {
+				return nil, fmt.Errorf("out of data")
+			}
+			return generator(i)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 100, result["sent"])
+		assert.Equal(t, 1, result["batches"])
+		assert.Equal(t, 151, calls)
+		assert.Contains(t, result["error"], "generator failed at index 150: out of data")
+
+		err = client.DeleteCollection("TestBatchStream")
+		assert.NoError(t, err)
+	})
+
+	t.Run("batch create from a stream", func(t *testing.T) {
+		err := client.CreateCollection("TestBatchStreaming", map[string]interface{}{
+			"vectorizer": "none",
+		})
+		require.NoError(t, err)
+
+		objects := make(chan map[string]interface{})
+		go func() {
+			defer close(objects)
+			for i := 0; i < 250; i++ {
+				objects <- map[string]interface{}{
+					"properties": map[string]interface{}{"position": i},
+					"vector":     []interface{}{float64(i), 1.0, 2.0},
+				}
+			}
+		}()
+		result, err := client.BatchCreateStreaming(objects, 100, map[string]interface{}{
+			"className": "TestBatchStreaming",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 250, result["sent"])
+		assert.Equal(t, 3, result["batches"])
+		assert.NotContains(t, result, "error")
+
+		count, err := client.GetObjectsCount("TestBatchStreaming", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(250), count)
+
+		// a function stream ends when it returns nil
+		remaining := 10
+		result, err = client.BatchCreateStreaming(func() map[string]interface{} {
+			if remaining == 0 {
+				return nil
+			}
+			remaining--
+			return map[string]interface{}{"class": "TestBatchStreaming", "properties": map[string]interface{}{"position": remaining}}
+		}, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 10, result["sent"])
+		assert.Equal(t, 1, result["batches"])
+
+		_, err = client.BatchCreateStreaming([]interface{}{}, 10, nil)
+		assert.ErrorContains(t, err, "stream must be a function returning objects or a channel of objects")
+
+		err = client.DeleteCollection("TestBatchStreaming")
+		assert.NoError(t, err)
+	})
+}
+
+func TestBatchResults(t *testing.T) {
+	var failRequest atomic.Bool
+	server := newFakeServer(t, `{}`, map[string]http.HandlerFunc{
+		"/v1/batch/objects": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if failRequest.Load() {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error": [{"message": "shard unavailable"}]}`))
+				return
+			}
+			w.Write([]byte(`[
+				{"class": "Article", "id": "00000000-0000-0000-0000-000000000001", "result": {"status": "SUCCESS"}},
+				{"class": "Article", "id": "00000000-0000-0000-0000-000000000002", "result": {"status": "FAILED", "errors": {"error": [{"message": "invalid vector"}, {"message": "invalid property"}]}}}
+			]`))
+		},
+	})
+	client := server.client(t)
+	objects := []map[string]interface{}{
+		{"class": "Article", "id": "00000000-0000-0000-0000-000000000001"},
+		{"class": "Article", "id": "00000000-0000-0000-0000-000000000002"},
+	}
+
+	t.Run("object errors", func(t *testing.T) {
+		results, err := client.BatchCreate(objects)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "success", results[0]["status"])
+		assert.Equal(t, "error", results[1]["status"])
+		assert.Equal(t, 1, results[1]["index"])
+		assert.IsType(t, []*models.ErrorResponseErrorItems0{}, results[1]["error"])
+		assert.Equal(t, []*models.ErrorResponseErrorItems0{{Message: "invalid vector"}, {Message: "invalid property"}}, results[1]["error"])
+		assert.Equal(t, []string{"invalid vector", "invalid property"}, results[1]["errorMessages"])
+
+		withOptions, err := client.BatchCreateWithOptions(objects, nil)
+		require.NoError(t, err)
+		assert.Equal(t, results, withOptions["results"])
+		assert.Equal(t, 1, withOptions["successful"])
+		assert.Equal(t, 1, withOptions["failed"])
+	})
+
+	t.Run("request errors", func(t *testing.T) {
+		failRequest.Store(true)
+		defer failRequest.Store(false)
+
+		result, err := client.BatchCreateWithOptions(objects, nil)
+		require.NoError(t, err)
+		results, _ := result["results"].([]map[string]interface{})
+		require.Len(t, results, 2)
+		for i, res := range results {
+			assert.Equal(t, i, res["index"])
+			assert.Equal(t, "error", res["status"])
+			assert.Equal(t, http.StatusInternalServerError, res["httpStatus"])
+			items, ok := res["error"].([]*models.ErrorResponseErrorItems0)
+			if assert.True(t, ok, "request errors are error items like object errors") && assert.Len(t, items, 1) {
+				assert.Contains(t, items[0].Message, "shard unavailable")
+				assert.Equal(t, []string{items[0].Message}, res["errorMessages"])
+			}
+		}
+		assert.Equal(t, 2, result["failed"])
+	})
 }
@@ -1,6 +1,9 @@
 package tests
 
 import (
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,4 +39,268 @@ func TestCollectionManagement(t *testing.T) {
 		err = client.DeleteCollection("TestCollection")
 		assert.NoError(t, err)
 	})
+
+	t.Run("ensure collection", func(t *testing.T) {
+		config := map[string]interface{}{
+			"vectorizer": "none",
+			"properties": []map[string]interface{}{
+				{"name": "title", "dataType": []string{"text"}},
+			},
+		}
+		exists, err := client.CollectionExists("TestEnsureCollection")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		// setup runs on every test run, the second call finds the collection
+		assert.NoError(t, client.EnsureCollection("TestEnsureCollection", config))
+		assert.NoError(t, client.EnsureCollection("TestEnsureCollection", config))
+
+		exists, err = client.CollectionExists("TestEnsureCollection")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		err = client.DeleteCollection("TestEnsureCollection")
+		assert.NoError(t, err)
+	})
+
+	t.Run("create collection with inverted index options", func(t *testing.T) {
+		err := client.CreateCollection("TestInvertedIndexCollection", map[string]interface{}{
+			"invertedIndexConfig": map[string]interface{}{
+				"indexTimestamps":        true,
+				"indexNullState":         true,
+				"indexPropertyLength":    true,
+				"cleanupIntervalSeconds": float64(120),
+			},
+		})
+		assert.NoError(t, err)
+
+		err = client.DeleteCollection("TestInvertedIndexCollection")
+		assert.NoError(t, err)
+	})
+
+	t.Run("create collections with cross references", func(t *testing.T) {
+		// Article references Author before Author exists and vice versa
+		err := client.CreateCollections([]map[string]interface{}{
+			{
+				"name": "TestArticle",
+				"properties": []interface{}{
+					map[string]interface{}{"name": "title", "dataType": []interface{}{"text"}},
+					map[string]interface{}{"name": "writtenBy", "dataType": []interface{}{"TestAuthor"}},
+				},
+			},
+			{
+				"name": "TestAuthor",
+				"properties": []interface{}{
+					map[string]interface{}{"name": "name", "dataType": []interface{}{"text"}},
+					map[string]interface{}{"name": "wrote", "dataType": []interface{}{"TestArticle"}},
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, client.DeleteCollection("TestArticle"))
+		assert.NoError(t, client.DeleteCollection("TestAuthor"))
+	})
+
+	t.Run("create collection with quantization", func(t *testing.T) {
+		err := client.CreateCollection("TestQuantizedCollection", map[string]interface{}{
+			"vectorizer":      "none",
+			"vectorIndexType": "hnsw",
+			"vectorIndexConfig": map[string]interface{}{
+				"pq": map[string]interface{}{
+					"enabled":       true,
+					"trainingLimit": float64(100000),
+					"segments":      float64(96),
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		err = client.DeleteCollection("TestQuantizedCollection")
+		assert.NoError(t, err)
+	})
+
+	t.Run("reject invalid quantization config", func(t *testing.T) {
+		err := client.CreateCollection("TestInvalidQuantization", map[string]interface{}{
+			"vectorIndexConfig": map[string]interface{}{
+				"pq": map[string]interface{}{"enabled": true},
+				"bq": map[string]interface{}{"enabled": true},
+			},
+		})
+		assert.ErrorContains(t, err, "only one quantizer can be enabled")
+
+		err = client.CreateCollection("TestInvalidQuantization", map[string]interface{}{
+			"vectorConfig": map[string]interface{}{
+				"default": map[string]interface{}{
+					"vectorIndexConfig": map[string]interface{}{
+						"sq": map[string]interface{}{"enabeld": true},
+					},
+				},
+			},
+		})
+		assert.ErrorContains(t, err, "unknown sq option")
+	})
+
+	t.Run("create collection with module config", func(t *testing.T) {
+		err := client.CreateCollection("TestModuleConfig", map[string]interface{}{
+			"vectorizer": "none",
+			"moduleConfig": map[string]interface{}{
+				"generative-openai": map[string]interface{}{
+					"model": "gpt-4o-mini",
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		exported, err := client.ExportCollectionJSON("TestModuleConfig")
+		assert.NoError(t, err)
+		assert.Contains(t, exported, `"generative-openai":{"model":"gpt-4o-mini"}`)
+
+		err = client.DeleteCollection("TestModuleConfig")
+		assert.NoError(t, err)
+
+		err = client.CreateCollection("TestModuleConfig", map[string]interface{}{
+			"moduleConfig": "text2vec-openai",
+		})
+		assert.ErrorContains(t, err, "moduleConfig must be an object")
+	})
+
+	t.Run("create collection from JSON and export it", func(t *testing.T) {
+		err := client.CreateCollectionFromJSON(`{
+			"class": "TestJSONCollection",
+			"vectorizer": "none",
+			"invertedIndexConfig": {"indexTimestamps": true},
+			"properties": [
+				{"name": "title", "dataType": ["text"], "tokenization": "word"}
+			]
+		}`)
+		assert.NoError(t, err)
+
+		exported, err := client.ExportCollectionJSON("TestJSONCollection")
+		assert.NoError(t, err)
+		assert.Contains(t, exported, `"class":"TestJSONCollection"`)
+		assert.Contains(t, exported, `"indexTimestamps":true`)
+
+		schema, err := client.GetSchemaJSON()
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(schema, `{"classes":[`))
+		assert.Contains(t, schema, `"class":"TestJSONCollection"`)
+
+		err = client.DeleteCollection("TestJSONCollection")
+		assert.NoError(t, err)
+	})
+
+	t.Run("reject invalid collection JSON", func(t *testing.T) {
+		err := client.CreateCollectionFromJSON(`{"class": "TestInvalidJSON", "properties": [{"name": "title", "dataType": "text"}]}`)
+		assert.ErrorContains(t, err, "properties.0.dataType")
+
+		err = client.CreateCollectionFromJSON(`{"class": "TestInvalidJSON", "properties": [{"name": "title", "dataType": ["text"], "tokenization": "words"}]}`)
+		assert.ErrorContains(t, err, "properties.0.tokenization")
+	})
+
+	t.Run("wait for collection ready", func(t *testing.T) {
+		err := client.CreateCollection("TestReadyCollection", map[string]interface{}{
+			"vectorizer": "none",
+		})
+		assert.NoError(t, err)
+
+		waited, err := client.WaitForCollectionReady("TestReadyCollection", map[string]interface{}{
+			"timeoutMs":      10000,
+			"pollIntervalMs": 100,
+		})
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, waited, int64(0))
+
+		_, err = client.BatchCreate([]map[string]interface{}{
+			{"class": "TestReadyCollection", "vector": []interface{}{0.1, 0.2, 0.3}},
+			{"class": "TestReadyCollection", "vector": []interface{}{0.4, 0.5, 0.6}},
+		})
+		assert.NoError(t, err)
+		err = client.WaitForVectorization("TestReadyCollection", 100, 10000)
+		assert.NoError(t, err)
+
+		drained, err := client.WaitForIndexing("TestReadyCollection", 10000, "")
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, drained, int64(0))
+		size, err := client.GetVectorQueueSize("TestReadyCollection", "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), size)
+
+		err = client.DeleteCollection("TestReadyCollection")
+		assert.NoError(t, err)
+	})
+
+	t.Run("add property with module config", func(t *testing.T) {
+		err := client.CreateCollection("TestAddProperty", map[string]interface{}{
+			"vectorizer": "none",
+		})
+		assert.NoError(t, err)
+
+		err = client.AddProperty("TestAddProperty", map[string]interface{}{
+			"name":     "summary",
+			"dataType": []interface{}{"text"},
+			"moduleConfig": map[string]interface{}{
+				"text2vec-contextionary": map[string]interface{}{
+					"skip":                  true,
+					"vectorizePropertyName": false,
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		err = client.AddProperty("TestAddProperty", map[string]interface{}{
+			"dataType": []interface{}{"text"},
+		})
+		assert.ErrorContains(t, err, "property missing name")
+
+		err = client.DeleteCollection("TestAddProperty")
+		assert.NoError(t, err)
+	})
+}
+
+func TestInvertedIndexConfigNumbers(t *testing.T) {
+	var created map[string]interface{}
+	server := newFakeServer(t, `{}`, map[string]http.HandlerFunc{
+		"/v1/schema": func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&created)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"class": "TestBM25"}`))
+		},
+	})
+	client := server.client(t)
+
+	t.Run("numbers from JS", func(t *testing.T) {
+		// JS numbers come as float64, whole numbers as int64
+		err := client.CreateCollection("TestBM25", map[string]interface{}{
+			"invertedIndexConfig": map[string]interface{}{
+				"bm25":                   map[string]interface{}{"k1": 1.5, "b": int64(1)},
+				"cleanupIntervalSeconds": int64(30),
+			},
+		})
+		assert.NoError(t, err)
+		config := created["invertedIndexConfig"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"k1": 1.5, "b": 1.0}, config["bm25"])
+		assert.Equal(t, 30.0, config["cleanupIntervalSeconds"])
+	})
+
+	t.Run("left out bm25 parameters keep the defaults", func(t *testing.T) {
+		err := client.CreateCollection("TestBM25", map[string]interface{}{
+			"invertedIndexConfig": map[string]interface{}{"bm25": map[string]interface{}{"b": 0.5}},
+		})
+		assert.NoError(t, err)
+		config := created["invertedIndexConfig"].(map[string]interface{})
+		assert.InDelta(t, 1.2, config["bm25"].(map[string]interface{})["k1"], 0.0001)
+	})
+
+	t.Run("non-numeric values", func(t *testing.T) {
+		err := client.CreateCollection("TestBM25", map[string]interface{}{
+			"invertedIndexConfig": map[string]interface{}{"bm25": map[string]interface{}{"k1": "high"}},
+		})
+		assert.ErrorContains(t, err, "invertedIndexConfig.bm25.k1 must be a number")
+
+		err = client.CreateCollection("TestBM25", map[string]interface{}{
+			"invertedIndexConfig": map[string]interface{}{"cleanupIntervalSeconds": "often"},
+		})
+		assert.ErrorContains(t, err, "invertedIndexConfig.cleanupIntervalSeconds must be an integer")
+	})
 }
@@ -1,13 +1,18 @@
 package tests
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/xk6-weaviate"
 )
 
 func TestObjectInsert(t *testing.T) {
@@ -35,16 +40,16 @@ func TestObjectInsert(t *testing.T) {
 
 		result, err := client.ObjectInsert(className, obj)
 		assert.NoError(t, err)
-		assert.NotEmpty(t, result["id"])
-		assert.Equal(t, "Test Document", result["properties"].(map[string]interface{})["title"])
+		assert.NotEmpty(t, result.ID)
+		assert.Equal(t, "Test Document", result.Properties["title"])
 		fetched, err := client.FetchObjects(className, map[string]interface{}{
-			"id":         result["id"],
+			"id":         result.ID,
 			"additional": []string{"vector"},
 		})
 		assert.NoError(t, err)
 		assert.Len(t, fetched["objects"], 1)
 		objects := fetched["objects"].([]map[string]interface{})
-		assert.Equal(t, result["id"], objects[0]["id"])
+		assert.Equal(t, result.ID, objects[0]["id"])
 
 		// Verify vector content
 		vector := objects[0]["vector"].(models.C11yVector)
@@ -81,7 +86,7 @@ func TestObjectInsert(t *testing.T) {
 
 		result, err := client.ObjectInsert(className, obj)
 		assert.NoError(t, err)
-		assert.Equal(t, customID, result["id"])
+		assert.Equal(t, customID, result.ID)
 		fetched, err := client.FetchObjects(className, map[string]interface{}{
 			"id":         customID,
 			"additional": []string{"vector"},
@@ -138,9 +143,9 @@ func TestObjectInsert(t *testing.T) {
 
 		result, err := client.ObjectInsert(className, obj)
 		assert.NoError(t, err)
-		assert.Len(t, result["vectors"], 2)
+		assert.Len(t, result.Vectors, 2)
 		fetched, err := client.FetchObjects(className, map[string]interface{}{
-			"id":         result["id"],
+			"id":         result.ID,
 			"additional": []string{"vector"},
 		})
 		assert.NoError(t, err)
@@ -172,6 +177,48 @@ func TestObjectInsert(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Insert with multi-vector", func(t *testing.T) {
+		className := "TestInsertMultiVector_" + time.Now().Format("20060102150405")
+
+		err := client.CreateCollection(className, map[string]interface{}{
+			"vectorConfig": map[string]interface{}{
+				"colbert": map[string]interface{}{
+					"vectorizer": map[string]interface{}{
+						"none": map[string]interface{}{},
+					},
+					"vectorIndexType": "hnsw",
+					"vectorIndexConfig": map[string]interface{}{
+						"multiVector": map[string]interface{}{
+							"enabled":     true,
+							"aggregation": "maxSim",
+						},
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		obj := map[string]interface{}{
+			"properties": map[string]interface{}{
+				"title": "Multi Vector Doc",
+			},
+			"vectors": map[string]interface{}{
+				"colbert": []interface{}{
+					[]interface{}{0.1, 0.2, 0.3},
+					[]interface{}{0.4, 0.5, 0.6},
+				},
+			},
+		}
+
+		result, err := client.ObjectInsert(className, obj)
+		require.NoError(t, err)
+		vectors := result.Vectors
+		assert.Len(t, vectors["colbert"], 2)
+
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
 	t.Run("Insert with tenant", func(t *testing.T) {
 		className := "TestInsertMTClass_" + time.Now().Format("20060102150405")
 		// Create test class
@@ -203,9 +250,9 @@ func TestObjectInsert(t *testing.T) {
 
 		result, err := client.ObjectInsert(className, obj)
 		assert.NoError(t, err)
-		assert.Equal(t, tenantName, result["tenant"])
+		assert.Equal(t, tenantName, result.Tenant)
 		fetched, err := client.FetchObjects(className, map[string]interface{}{
-			"id":         result["id"],
+			"id":         result.ID,
 			"tenant":     tenantName,
 			"additional": []string{"vector"},
 		})
@@ -217,6 +264,128 @@ func TestObjectInsert(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Insert with geoCoordinates", func(t *testing.T) {
+		className := "TestInsertGeoClass_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []interface{}{
+				map[string]interface{}{
+					"name":     "location",
+					"dataType": []interface{}{"geoCoordinates"},
+				},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+		obj := map[string]interface{}{
+			"properties": map[string]interface{}{
+				"location": map[string]interface{}{
+					"latitude":  52.3932696,
+					"longitude": 4.8374263,
+				},
+			},
+		}
+
+		result, err := client.ObjectInsert(className, obj)
+		assert.NoError(t, err)
+		fetched, err := client.FetchObjects(className, map[string]interface{}{
+			"id": result.ID,
+		})
+		assert.NoError(t, err)
+		objects := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		location := objects[0]["properties"].(map[string]interface{})["location"].(map[string]interface{})
+		assert.InDelta(t, 52.3932696, location["latitude"], 0.0001)
+		assert.InDelta(t, 4.8374263, location["longitude"], 0.0001)
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Insert with date", func(t *testing.T) {
+		className := "TestInsertDateClass_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []interface{}{
+				map[string]interface{}{
+					"name":     "published",
+					"dataType": []interface{}{"date"},
+				},
+				map[string]interface{}{
+					"name":     "note",
+					"dataType": []interface{}{"text"},
+				},
+				map[string]interface{}{
+					"name":     "labels",
+					"dataType": []interface{}{"text[]"},
+				},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+		// date-shaped text must not be parsed, nor truncated to milliseconds
+		obj := map[string]interface{}{
+			"properties": map[string]interface{}{
+				"published": "2024-05-01T14:30:00+02:00",
+				"note":      "2024-01-02T03:04:05.123456Z",
+				"labels":    []interface{}{"2024-01-02T03:04:05.123456Z"},
+			},
+		}
+
+		result, err := client.ObjectInsert(className, obj)
+		require.NoError(t, err)
+		fetched, err := client.FetchObjects(className, map[string]interface{}{
+			"id": result.ID,
+		})
+		require.NoError(t, err)
+		objects := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		published, ok := objects[0]["properties"].(map[string]interface{})["published"].(string)
+		require.True(t, ok, "date should be returned as a string")
+		parsed, err := time.Parse(time.RFC3339, published)
+		require.NoError(t, err)
+		assert.True(t, parsed.Equal(time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)), "unexpected date %s", published)
+		properties := objects[0]["properties"].(map[string]interface{})
+		assert.Equal(t, "2024-01-02T03:04:05.123456Z", properties["note"])
+		assert.Equal(t, []interface{}{"2024-01-02T03:04:05.123456Z"}, properties["labels"])
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Insert with large int", func(t *testing.T) {
+		className := "TestInsertIntClass_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []interface{}{
+				map[string]interface{}{"name": "timestamp", "dataType": []interface{}{"int"}},
+				map[string]interface{}{"name": "counts", "dataType": []interface{}{"int[]"}},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+		// goja hands integers beyond its int range to Go as float64
+		obj := map[string]interface{}{
+			"properties": map[string]interface{}{
+				"timestamp": float64(1 << 60),
+				"counts":    []interface{}{int64(1), float64(1 << 60)},
+			},
+		}
+
+		result, err := client.ObjectInsert(className, obj)
+		require.NoError(t, err)
+		batchResults, err := client.BatchCreate([]map[string]interface{}{
+			{"class": className, "properties": obj["properties"]},
+		})
+		require.NoError(t, err)
+		require.Len(t, batchResults, 1)
+		assert.Equal(t, "success", batchResults[0]["status"], batchResults[0]["error"])
+
+		fetched, err := client.FetchObjects(className, map[string]interface{}{
+			"id": result.ID,
+		})
+		require.NoError(t, err)
+		objects := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		timestamp, ok := weaviate.ToFloat64(objects[0]["properties"].(map[string]interface{})["timestamp"])
+		require.True(t, ok)
+		assert.Equal(t, float64(1<<60), timestamp)
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
 	t.Run("Insert with consistency level", func(t *testing.T) {
 		className := "TestInsertConsistencyClass_" + time.Now().Format("20060102150405")
 		// Create test class
@@ -238,7 +407,7 @@ func TestObjectInsert(t *testing.T) {
 
 		result, err := client.ObjectInsert(className, obj)
 		assert.NoError(t, err)
-		assert.NotEmpty(t, result["id"])
+		assert.NotEmpty(t, result.ID)
 		err = client.DeleteCollection(className)
 		assert.NoError(t, err)
 	})
@@ -268,6 +437,163 @@ func TestObjectInsert(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Dry run insert", func(t *testing.T) {
+		className := "TestDryRunInsert_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []map[string]interface{}{
+				{
+					"name":     "title",
+					"dataType": []string{"text"},
+				},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+
+		result, err := client.ObjectInsert(className, map[string]interface{}{
+			"properties": map[string]interface{}{"title": "Valid Doc"},
+			"dryRun":     true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Valid Doc", result.Properties["title"])
+
+		_, err = client.ObjectInsert(className, map[string]interface{}{
+			"properties": map[string]interface{}{"title": 42},
+			"dryRun":     true,
+		})
+		assert.Error(t, err)
+
+		count, err := client.GetObjectsCount(className, "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Replace object", func(t *testing.T) {
+		className := "TestReplaceObject_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []map[string]interface{}{
+				{
+					"name":     "title",
+					"dataType": []string{"text"},
+				},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+
+		id := "00000000-0000-0000-0000-000000000619"
+		result, err := client.ObjectReplace(className, id, map[string]interface{}{
+			"properties": map[string]interface{}{"title": "First Version"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, true, result["created"])
+
+		result, err = client.ObjectReplace(className, id, map[string]interface{}{
+			"properties": map[string]interface{}{"title": "Second Version"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, false, result["created"])
+
+		fetched, err := client.FetchObjects(className, map[string]interface{}{"id": id})
+		require.NoError(t, err)
+		objects := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		assert.Equal(t, "Second Version", objects[0]["properties"].(map[string]interface{})["title"])
+
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Upsert object", func(t *testing.T) {
+		className := "TestUpsertObject_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []map[string]interface{}{
+				{
+					"name":     "title",
+					"dataType": []string{"text"},
+				},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+
+		id := "00000000-0000-0000-0000-000000000620"
+		for _, title := range []string{"First Version", "Second Version"} {
+			result, err := client.Upsert(className, id, map[string]interface{}{
+				"properties": map[string]interface{}{"title": title},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, id, result["id"])
+		}
+
+		fetched, err := client.FetchObjects(className, map[string]interface{}{"id": id})
+		require.NoError(t, err)
+		objects := fetched["objects"].([]map[string]interface{})
+		require.Len(t, objects, 1)
+		assert.Equal(t, "Second Version", objects[0]["properties"].(map[string]interface{})["title"])
+
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Insert without returned payload", func(t *testing.T) {
+		className := "TestInsertNoPayload_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []map[string]interface{}{
+				{
+					"name":     "title",
+					"dataType": []string{"text"},
+				},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+
+		result, err := client.ObjectInsert(className, map[string]interface{}{
+			"properties":    map[string]interface{}{"title": "No Payload Doc"},
+			"vector":        []interface{}{0.1, 0.2, 0.3},
+			"returnPayload": false,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.ID)
+		assert.Nil(t, result.Properties)
+		assert.Nil(t, result.Vector)
+
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Default consistency level", func(t *testing.T) {
+		className := "TestDefaultConsistencyClass_" + time.Now().Format("20060102150405")
+		err := client.CreateCollection(className, map[string]interface{}{
+			"properties": []map[string]interface{}{
+				{
+					"name":     "title",
+					"dataType": []string{"text"},
+				},
+			},
+		})
+		require.Nil(t, err, "Collection creation failed with error: %v", err)
+
+		assert.Error(t, client.SetConsistencyLevel("invalid"))
+		require.NoError(t, client.SetConsistencyLevel("QUORUM"))
+		defer client.SetConsistencyLevel("")
+
+		result, err := client.ObjectInsert(className, map[string]interface{}{
+			"properties": map[string]interface{}{"title": "Default Consistency Doc"},
+		})
+		require.NoError(t, err)
+
+		fetched, err := client.FetchObjects(className, map[string]interface{}{
+			"id":               result.ID,
+			"consistencyLevel": "one",
+		})
+		require.NoError(t, err)
+		assert.Len(t, fetched["objects"], 1)
+
+		err = client.DeleteCollection(className)
+		assert.NoError(t, err)
+	})
+
 	t.Run("Fetch objects with pagination", func(t *testing.T) {
 		className := "TestFetchPagination_" + time.Now().Format("20060102150405")
 		err := client.CreateCollection(className, map[string]interface{}{
@@ -289,7 +615,7 @@ func TestObjectInsert(t *testing.T) {
 			}
 			result, err := client.ObjectInsert(className, obj)
 			assert.NoError(t, err)
-			assert.Equal(t, orderedID, result["id"], "ID should match the ordered UUID")
+			assert.Equal(t, orderedID, result.ID, "ID should match the ordered UUID")
 		}
 
 		// Test limit only - should get first 2 objects
@@ -326,4 +652,194 @@ func TestObjectInsert(t *testing.T) {
 		err = client.DeleteCollection(className)
 		assert.NoError(t, err)
 	})
+
+	t.Run("Fetch objects with cross-references", func(t *testing.T) {
+		err := client.CreateCollections([]map[string]interface{}{
+			{
+				"name": "TestFetchBook",
+				"properties": []interface{}{
+					map[string]interface{}{"name": "title", "dataType": []interface{}{"text"}},
+					map[string]interface{}{"name": "writtenBy", "dataType": []interface{}{"TestFetchAuthor"}},
+				},
+			},
+			{
+				"name": "TestFetchAuthor",
+				"properties": []interface{}{
+					map[string]interface{}{"name": "name", "dataType": []interface{}{"text"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		author, err := client.ObjectInsert("TestFetchAuthor", map[string]interface{}{
+			"properties": map[string]interface{}{"name": "Ursula"},
+		})
+		require.NoError(t, err)
+		book, err := client.ObjectInsert("TestFetchBook", map[string]interface{}{
+			"properties": map[string]interface{}{
+				"title":     "The Dispossessed",
+				"writtenBy": []interface{}{map[string]interface{}{"beacon": "weaviate://localhost/TestFetchAuthor/" + author.ID}},
+			},
+		})
+		require.NoError(t, err)
+
+		fetched, err := client.FetchObjects("TestFetchBook", map[string]interface{}{
+			"id": book.ID,
+			"fields": []interface{}{
+				"title",
+				map[string]interface{}{"name": "writtenBy", "collection": "TestFetchAuthor", "fields": []interface{}{"name"}},
+			},
+		})
+		assert.NoError(t, err)
+		objects := fetched["objects"].([]map[string]interface{})
+		if assert.Len(t, objects, 1) {
+			properties := objects[0]["properties"].(map[string]interface{})
+			assert.Equal(t, "The Dispossessed", properties["title"])
+			authors, _ := properties["writtenBy"].([]interface{})
+			if assert.Len(t, authors, 1) {
+				assert.Equal(t, "Ursula", authors[0].(map[string]interface{})["name"])
+			}
+		}
+
+		_, err = client.FetchObjects("TestFetchBook", map[string]interface{}{
+			"fields": []interface{}{map[string]interface{}{"name": "writtenBy"}},
+		})
+		assert.ErrorContains(t, err, "requires a name and a collection")
+
+		// the target collection is read from the schema
+		authors, err := client.GetReferenceObjects("TestFetchBook", book.ID, "writtenBy", map[string]interface{}{
+			"fields": []interface{}{"name"},
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, authors, 1) {
+			assert.Equal(t, author.ID, authors[0]["id"])
+			assert.Equal(t, "Ursula", authors[0]["properties"].(map[string]interface{})["name"])
+		}
+
+		_, err = client.GetReferenceObjects("TestFetchBook", book.ID, "title", nil)
+		assert.ErrorContains(t, err, "is not a cross-reference")
+		_, err = client.GetReferenceObjects("TestFetchBook", "00000000-0000-0000-0000-000000000000", "writtenBy", nil)
+		var notFound *weaviate.NotFoundError
+		assert.ErrorAs(t, err, &notFound)
+
+		assert.NoError(t, client.DeleteCollection("TestFetchBook"))
+		assert.NoError(t, client.DeleteCollection("TestFetchAuthor"))
+	})
+}
+
+// TestPropertyTypeCache checks that the property types used to coerce values
+// are reread once the collection changes
+func TestNormalizeGeoCoordinates(t *testing.T) {
+	// whole numbers come from JS as int64
+	normalized := weaviate.NormalizeProperties(map[string]interface{}{
+		"location": map[string]interface{}{"latitude": int64(52), "longitude": 4.5},
+		"address":  map[string]interface{}{"latitude": "north", "longitude": 4.5},
+	})
+
+	location, ok := normalized["location"].(*models.GeoCoordinates)
+	if assert.True(t, ok, "an integral coordinate is a geo point") {
+		assert.Equal(t, float32(52), *location.Latitude)
+		assert.Equal(t, float32(4.5), *location.Longitude)
+	}
+	assert.IsType(t, map[string]interface{}{}, normalized["address"])
+}
+
+func TestPropertyTypeCache(t *testing.T) {
+	var schemaReads atomic.Int32
+	server := newFakeServer(t, `{}`, map[string]http.HandlerFunc{
+		"/v1/schema/Counter": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				schemaReads.Add(1)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"class": "Counter", "properties": [{"name": "value", "dataType": ["int"]}]}`))
+		},
+	})
+	client := server.client(t)
+	insert := func() {
+		_, err := client.ObjectInsert("Counter", map[string]interface{}{
+			"properties": map[string]interface{}{"value": float64(1 << 60)},
+		})
+		require.NoError(t, err)
+	}
+
+	insert()
+	insert()
+	assert.Equal(t, int32(1), schemaReads.Load(), "the schema is cached")
+
+	require.NoError(t, client.DeleteCollection("Counter"))
+	insert()
+	assert.Equal(t, int32(2), schemaReads.Load(), "deleting the collection drops the cache")
+
+	require.NoError(t, client.AddProperty("Counter", map[string]interface{}{
+		"name": "total", "dataType": []interface{}{"int"},
+	}))
+	insert()
+	assert.Equal(t, int32(3), schemaReads.Load(), "adding a property drops the cache")
+}
+
+func TestObjectReplaceEncoding(t *testing.T) {
+	const id = "00000000-0000-0000-0000-000000000001"
+	var mu sync.Mutex
+	sent := map[string]json.RawMessage{}
+	record := func(r *http.Request) {
+		var body struct {
+			Properties json.RawMessage `json:"properties"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		sent[r.Method] = body.Properties
+		mu.Unlock()
+	}
+	server := newFakeServer(t, `{}`, map[string]http.HandlerFunc{
+		"/v1/schema/Event": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"class": "Event", "properties": [{"name": "when", "dataType": ["date"]}, {"name": "count", "dataType": ["int"]}]}`))
+		},
+		"/v1/objects": func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"error": [{"message": "id '` + id + `' already exists"}]}`))
+		},
+		"/v1/objects/" + id: func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		},
+	})
+	client := server.client(t)
+
+	result, err := client.ObjectReplace("Event", id, map[string]interface{}{
+		"properties": map[string]interface{}{"when": "2024-01-02T03:04:05+02:00", "count": float64(1 << 60)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, result["created"])
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Contains(t, sent, http.MethodPut)
+	assert.JSONEq(t, string(sent[http.MethodPost]), string(sent[http.MethodPut]), "the insert and the update encode the properties alike")
+	assert.Contains(t, string(sent[http.MethodPut]), `"count":1152921504606846976`)
+	assert.Contains(t, string(sent[http.MethodPut]), `"when":"2024-01-02T03:04:05.000+02:00"`)
+}
+
+func TestGetReferenceObjects(t *testing.T) {
+	server := newFakeServer(t, `{"data": {"Get": {"Book": [{"writtenBy": [{"name": "Ann", "_additional": {"id": "00000000-0000-0000-0000-000000000002"}}], "_additional": {"id": "00000000-0000-0000-0000-000000000001"}}]}}}`, nil)
+	client := server.client(t)
+
+	authors, err := client.GetReferenceObjects("Book", "00000000-0000-0000-0000-000000000001", "writtenBy", map[string]interface{}{
+		"collection": []interface{}{"Author", "Editor"},
+		"fields":     []interface{}{"name"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{Get {Book (where:{operator: Equal path: ["id"] valueText: "00000000-0000-0000-0000-000000000001"}) {writtenBy{... on Author{name _additional{id}} ... on Editor{name _additional{id}}} _additional{id}}}}`, server.lastQuery())
+	require.Len(t, authors, 1)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000002", authors[0]["id"])
+	assert.Equal(t, "Ann", authors[0]["properties"].(map[string]interface{})["name"])
+
+	_, err = client.GetReferenceObjects("Book", "00000000-0000-0000-0000-000000000001", "writtenBy", map[string]interface{}{
+		"collection": []interface{}{1},
+	})
+	assert.ErrorContains(t, err, "collection must be a collection name or a list of them")
 }
@@ -0,0 +1,29 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRBAC(t *testing.T) {
+	client := createTestClient(t)
+
+	t.Run("invalid role assignments", func(t *testing.T) {
+		err := client.AssignRoles("load-test-user", []string{"viewer"}, "ldap")
+		assert.ErrorContains(t, err, "invalid user type")
+
+		err = client.RevokeRoles("load-test-user", nil, "db")
+		assert.ErrorContains(t, err, "at least one role is required")
+
+		_, err = client.GetRolesForUser("load-test-user", "ldap")
+		assert.ErrorContains(t, err, "invalid user type")
+	})
+
+	t.Run("permission requires an action", func(t *testing.T) {
+		_, err := client.HasPermission(map[string]interface{}{
+			"data": map[string]interface{}{"collection": "*"},
+		})
+		assert.ErrorContains(t, err, "permission requires an action")
+	})
+}
@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplication(t *testing.T) {
+	client := createTestClient(t)
+
+	t.Run("invalid replicate shard requests", func(t *testing.T) {
+		operation := map[string]interface{}{
+			"collection": "ReplicatedCollection",
+			"shard":      "shard1",
+			"sourceNode": "node1",
+		}
+		_, err := client.ReplicateShard(operation)
+		assert.ErrorContains(t, err, "targetNode is required")
+
+		operation["targetNode"] = "node2"
+		operation["type"] = "SWAP"
+		_, err = client.ReplicateShard(operation)
+		assert.ErrorContains(t, err, "invalid replication type")
+	})
+
+	t.Run("unknown replication operation", func(t *testing.T) {
+		_, err := client.GetReplicationOperation("00000000-0000-0000-0000-000000000000")
+		assert.Error(t, err)
+	})
+}
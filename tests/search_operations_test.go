@@ -0,0 +1,417 @@
+package tests
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerativeSearch(t *testing.T) {
+	server := newFakeServer(t, `{"data": {"Get": {"Article": [{"title": "Vectors", "_additional": {"generate": {"singleResult": "A summary", "error": null}}}]}}}`, nil)
+	client := server.client(t)
+
+	t.Run("single result", func(t *testing.T) {
+		objects, err := client.GenerativeSearch("Article", map[string]interface{}{
+			"singleResultPrompt": "Summarize {title}",
+			"fields":             []interface{}{"title"},
+			"limit":              3,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{Get {Article (limit: 3) {title _additional{id generate(singleResult:{prompt:"""Summarize {title}"""}){singleResult error}}}}}`, server.lastQuery())
+		if assert.Len(t, objects, 1) {
+			assert.Equal(t, "Vectors", objects[0]["properties"].(map[string]interface{})["title"])
+		}
+	})
+
+	t.Run("grouped result", func(t *testing.T) {
+		_, err := client.GenerativeSearch("Article", map[string]interface{}{
+			"groupedResultTask":       "Compare the articles",
+			"groupedResultProperties": []interface{}{"title"},
+			"fields":                  []interface{}{"title"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{Get {Article  {title _additional{id generate(groupedResult:{task:"""Compare the articles""",properties:["title"]}){groupedResult error}}}}}`, server.lastQuery())
+	})
+
+	t.Run("with a model", func(t *testing.T) {
+		_, err := client.GenerativeSearch("Article", map[string]interface{}{
+			"singleResultPrompt": "Summarize {title}",
+			"groupedResultTask":  "Compare the articles",
+			"fields":             []interface{}{"title"},
+			"generate": map[string]interface{}{
+				"provider": "openai",
+				"model":    "gpt-4o-mini",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{Get {Article  {title _additional{id generate(singleResult:{prompt:"Summarize {title}" openai:{model:"gpt-4o-mini"}} groupedResult:{task:"Compare the articles" openai:{model:"gpt-4o-mini"}}){singleResult groupedResult error}}}}}`, server.lastQuery())
+	})
+
+	t.Run("requires a prompt or task", func(t *testing.T) {
+		_, err := client.GenerativeSearch("TestGenerative", map[string]interface{}{
+			"nearText": map[string]interface{}{
+				"concepts": []interface{}{"vector databases"},
+			},
+			"limit": 3,
+		})
+		assert.ErrorContains(t, err, "singleResultPrompt or groupedResultTask is required")
+	})
+
+	t.Run("requires a provider with a model", func(t *testing.T) {
+		_, err := client.GenerativeSearch("TestGenerative", map[string]interface{}{
+			"singleResultPrompt": "Summarize {title}",
+			"generate": map[string]interface{}{
+				"model": "gpt-4o-mini",
+			},
+		})
+		assert.ErrorContains(t, err, "generate.provider is required")
+	})
+}
+
+func TestGraphQLSearch(t *testing.T) {
+	client := createTestClient(t)
+	defer client.DeleteAllCollections()
+
+	err := client.CreateCollection("TestSearch", map[string]interface{}{
+		"vectorizer": "none",
+		"properties": []interface{}{
+			map[string]interface{}{"name": "title", "dataType": []interface{}{"text"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	titles := []string{"vector search", "vector database", "keyword search"}
+	ids := make([]string, 0, len(titles))
+	for i, title := range titles {
+		result, err := client.ObjectInsert("TestSearch", map[string]interface{}{
+			"properties": map[string]interface{}{"title": title},
+			"vector":     []interface{}{float64(i), 1.0, 0.5},
+		})
+		if assert.NoError(t, err) {
+			ids = append(ids, result.ID)
+		}
+	}
+
+	t.Run("multi get in id order", func(t *testing.T) {
+		missing := "00000000-0000-0000-0000-000000000000"
+		objects, err := client.MultiGet("TestSearch", []string{ids[2], missing, ids[0]}, map[string]interface{}{
+			"fields": []interface{}{"title"},
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, objects, 2) {
+			assert.Equal(t, ids[2], objects[0]["id"])
+			assert.Equal(t, ids[0], objects[1]["id"])
+			assert.Equal(t, "keyword search", objects[0]["properties"].(map[string]interface{})["title"])
+		}
+	})
+
+	t.Run("raw graphql", func(t *testing.T) {
+		data, err := client.RawGraphQL(`{ Aggregate { TestSearch { meta { count } } } }`, nil)
+		assert.NoError(t, err)
+		aggregate, _ := data["Aggregate"].(map[string]interface{})
+		results, _ := aggregate["TestSearch"].([]interface{})
+		if assert.Len(t, results, 1) {
+			meta := results[0].(map[string]interface{})["meta"].(map[string]interface{})
+			assert.Equal(t, float64(3), meta["count"])
+		}
+
+		_, err = client.RawGraphQL(`{ Get { TestSearch { missingField } } }`, nil)
+		assert.ErrorContains(t, err, "graphql error")
+	})
+
+	t.Run("bm25 with autoCut", func(t *testing.T) {
+		result, err := client.GraphQLBM25("TestSearch", map[string]interface{}{
+			"query":   "vector",
+			"fields":  []interface{}{"title"},
+			"autoCut": 1,
+		})
+		assert.NoError(t, err)
+		objects, _ := result["objects"].([]map[string]interface{})
+		assert.NotEmpty(t, objects)
+		assert.LessOrEqual(t, len(objects), 2)
+	})
+
+	t.Run("hybrid with autoCut", func(t *testing.T) {
+		result, err := client.GraphQLHybrid("TestSearch", map[string]interface{}{
+			"query":   "vector search",
+			"alpha":   0.5,
+			"vector":  []interface{}{0.0, 1.0, 0.5},
+			"fields":  []interface{}{"title"},
+			"autoCut": 1,
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, result, "objects")
+	})
+
+	t.Run("nearVector with groupBy", func(t *testing.T) {
+		result, err := client.GraphQLGet("TestSearch", map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector": []interface{}{0.0, 1.0, 0.5},
+			},
+			"fields": []interface{}{"title"},
+			"groupBy": map[string]interface{}{
+				"path":            []interface{}{"title"},
+				"groups":          2,
+				"objectsPerGroup": 1,
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotContains(t, result, "objects")
+		groups, _ := result["groups"].([]map[string]interface{})
+		assert.Len(t, groups, 2)
+		for _, group := range groups {
+			assert.Len(t, group["objects"], 1)
+		}
+	})
+
+	t.Run("get with sort", func(t *testing.T) {
+		result, err := client.GraphQLGet("TestSearch", map[string]interface{}{
+			"fields": []interface{}{"title"},
+			"sort": []interface{}{
+				map[string]interface{}{"path": []interface{}{"title"}, "order": "desc"},
+			},
+		})
+		assert.NoError(t, err)
+		objects, _ := result["objects"].([]map[string]interface{})
+		if assert.Len(t, objects, len(titles)) {
+			first, _ := objects[0]["properties"].(map[string]interface{})
+			assert.Equal(t, "vector search", first["title"])
+		}
+
+		_, err = client.GraphQLBM25("TestSearch", map[string]interface{}{
+			"query": "vector",
+			"sort": []interface{}{
+				map[string]interface{}{"path": []interface{}{"title"}, "order": "up"},
+			},
+		})
+		assert.ErrorContains(t, err, "invalid sort order")
+	})
+
+	t.Run("reject nearText move without force", func(t *testing.T) {
+		_, err := client.GraphQLGet("TestSearch", map[string]interface{}{
+			"nearText": map[string]interface{}{
+				"concepts": []interface{}{"search"},
+				"moveAwayFrom": map[string]interface{}{
+					"concepts": []interface{}{"keyword"},
+				},
+			},
+		})
+		assert.ErrorContains(t, err, "nearText.moveAwayFrom requires a numeric force")
+	})
+
+	t.Run("nearImage requires an image", func(t *testing.T) {
+		_, err := client.GraphQLNearImage("TestSearch", map[string]interface{}{
+			"limit": 1,
+		})
+		assert.ErrorContains(t, err, "image is required")
+	})
+
+	t.Run("near-media searches require media", func(t *testing.T) {
+		searches := map[string]func(string, map[string]interface{}) ([]map[string]interface{}, error){
+			"audio":   client.GraphQLNearAudio,
+			"video":   client.GraphQLNearVideo,
+			"depth":   client.GraphQLNearDepth,
+			"imu":     client.GraphQLNearIMU,
+			"thermal": client.GraphQLNearThermal,
+		}
+		for name, search := range searches {
+			_, err := search("TestSearch", map[string]interface{}{"image": "aGVsbG8="})
+			assert.ErrorContains(t, err, "media is required", name)
+		}
+	})
+
+	t.Run("reject both certainty and distance", func(t *testing.T) {
+		_, err := client.GraphQLGet("TestSearch", map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector":    []interface{}{0.0, 1.0, 0.5},
+				"certainty": 0.8,
+				"distance":  0.2,
+			},
+		})
+		assert.ErrorContains(t, err, "specify either certainty or distance, not both")
+	})
+
+	t.Run("reject non-integer autoCut", func(t *testing.T) {
+		_, err := client.GraphQLGet("TestSearch", map[string]interface{}{
+			"autoCut": "one",
+		})
+		assert.ErrorContains(t, err, "autoCut must be an integer")
+	})
+}
+
+func TestNearMediaSources(t *testing.T) {
+	var fetches atomic.Int32
+	server := newFakeServer(t, `{"data": {"Get": {"TestMedia": []}}}`, map[string]http.HandlerFunc{
+		"/media/cat.png": func(w http.ResponseWriter, r *http.Request) {
+			fetches.Add(1)
+			w.Write([]byte("hello"))
+		},
+		"/media/dog.png": func(w http.ResponseWriter, r *http.Request) {
+			fetches.Add(1)
+			w.Write([]byte("hello"))
+		},
+		"/media/missing.png": http.NotFound,
+	})
+	client := server.client(t)
+
+	t.Run("url is fetched once", func(t *testing.T) {
+		query := map[string]interface{}{
+			"image": map[string]interface{}{"url": server.URL + "/media/cat.png"},
+		}
+		for i := 0; i < 3; i++ {
+			_, err := client.GraphQLNearImage("TestMedia", query)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(1), fetches.Load())
+		assert.Contains(t, server.lastQuery(), `image: "aGVsbG8="`)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "clip.wav")
+		assert.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+		_, err := client.GraphQLNearAudio("TestMedia", map[string]interface{}{
+			"media": map[string]interface{}{"file": path},
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, server.lastQuery(), `audio: "aGVsbG8="`)
+	})
+
+	t.Run("a string may be a url or a file", func(t *testing.T) {
+		before := fetches.Load()
+		_, err := client.GraphQLNearImage("TestMedia", map[string]interface{}{"image": server.URL + "/media/dog.png"})
+		assert.NoError(t, err)
+		assert.Equal(t, before+1, fetches.Load())
+		assert.Contains(t, server.lastQuery(), `image: "aGVsbG8="`)
+
+		path := filepath.Join(t.TempDir(), "cat.png")
+		assert.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+		_, err = client.GraphQLNearImage("TestMedia", map[string]interface{}{"image": path})
+		assert.NoError(t, err)
+		assert.Contains(t, server.lastQuery(), `image: "aGVsbG8="`)
+
+		_, err = client.GraphQLNearImage("TestMedia", map[string]interface{}{"image": "b3RoZXI="})
+		assert.NoError(t, err)
+		assert.Contains(t, server.lastQuery(), `image: "b3RoZXI="`)
+	})
+
+	t.Run("the object form overrides detection", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "aGVsbG8=")
+		assert.NoError(t, os.WriteFile(path, []byte("other"), 0o600))
+		_, err := client.GraphQLNearImage("TestMedia", map[string]interface{}{
+			"image": map[string]interface{}{"base64": path},
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, server.lastQuery(), `image: "`+path+`"`)
+	})
+
+	t.Run("every media type", func(t *testing.T) {
+		searches := map[string]func(string, map[string]interface{}) ([]map[string]interface{}, error){
+			"nearAudio":   client.GraphQLNearAudio,
+			"nearVideo":   client.GraphQLNearVideo,
+			"nearDepth":   client.GraphQLNearDepth,
+			"nearIMU":     client.GraphQLNearIMU,
+			"nearThermal": client.GraphQLNearThermal,
+		}
+		for argument, search := range searches {
+			_, err := search("TestMedia", map[string]interface{}{
+				"media":         map[string]interface{}{"base64": "aGVsbG8="},
+				"distance":      0.3,
+				"targetVectors": []interface{}{"media"},
+			})
+			assert.NoError(t, err, argument)
+			query := server.lastQuery()
+			assert.Contains(t, query, argument+":", argument)
+			assert.Contains(t, query, "distance: 0.3", argument)
+			assert.Contains(t, query, `targetVectors: ["media"]`, argument)
+		}
+	})
+
+	t.Run("invalid sources", func(t *testing.T) {
+		_, err := client.GraphQLNearImage("TestMedia", map[string]interface{}{
+			"image": map[string]interface{}{"path": "cat.png"},
+		})
+		assert.ErrorContains(t, err, "invalid image source: path (valid options: base64, file, url)")
+
+		_, err = client.GraphQLNearImage("TestMedia", map[string]interface{}{
+			"image": map[string]interface{}{"file": "cat.png", "url": "http://example.com/cat.png"},
+		})
+		assert.ErrorContains(t, err, "image must be a base64 string, a file path, a URL or an object of one of base64, file and url")
+
+		_, err = client.GraphQLNearImage("TestMedia", map[string]interface{}{
+			"image": map[string]interface{}{"url": server.URL + "/media/missing.png"},
+		})
+		assert.ErrorContains(t, err, "status code 404")
+	})
+}
+
+func TestNamedVectorSearch(t *testing.T) {
+	client := createTestClient(t)
+	defer client.DeleteAllCollections()
+
+	err := client.CreateCollection("TestNamedVectorSearch", map[string]interface{}{
+		"properties": []interface{}{
+			map[string]interface{}{"name": "title", "dataType": []interface{}{"text"}},
+		},
+		"vectorConfig": map[string]interface{}{
+			"title_vector": map[string]interface{}{
+				"vectorizer":      map[string]interface{}{"none": nil},
+				"vectorIndexType": "hnsw",
+			},
+			"body_vector": map[string]interface{}{
+				"vectorizer":      map[string]interface{}{"none": nil},
+				"vectorIndexType": "hnsw",
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = client.ObjectInsert("TestNamedVectorSearch", map[string]interface{}{
+		"properties": map[string]interface{}{"title": "Named vectors"},
+		"vectors": map[string]interface{}{
+			"title_vector": []interface{}{0.1, 0.2, 0.3},
+			"body_vector":  []interface{}{0.4, 0.5, 0.6},
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Run("nearVector with targetVectors", func(t *testing.T) {
+		result, err := client.GraphQLGet("TestNamedVectorSearch", map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector":        []interface{}{0.1, 0.2, 0.3},
+				"targetVectors": []interface{}{"title_vector"},
+			},
+			"fields":     []interface{}{"title"},
+			"additional": []interface{}{"distance"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, result["objects"], 1)
+	})
+
+	t.Run("nearVector with target weights", func(t *testing.T) {
+		result, err := client.GraphQLGet("TestNamedVectorSearch", map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector": []interface{}{0.1, 0.2, 0.3},
+				"weights": map[string]interface{}{
+					"title_vector": 0.7,
+					"body_vector":  0.3,
+				},
+			},
+			"fields": []interface{}{"title"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, result["objects"], 1)
+
+		_, err = client.GraphQLGet("TestNamedVectorSearch", map[string]interface{}{
+			"nearVector": map[string]interface{}{
+				"vector":            []interface{}{0.1, 0.2, 0.3},
+				"weights":           map[string]interface{}{"title_vector": 1},
+				"combinationMethod": "sum",
+			},
+		})
+		assert.ErrorContains(t, err, "invalid combinationMethod with weights")
+	})
+}
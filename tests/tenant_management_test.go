@@ -1,9 +1,11 @@
 package tests
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/xk6-weaviate"
 )
 
 func TestTenantManagement(t *testing.T) {
@@ -33,6 +35,28 @@ func TestTenantManagement(t *testing.T) {
 		})
 		assert.NoError(t, err)
 
+		// List tenants
+		tenants, err := client.GetTenants("MultiTenantCollection")
+		assert.NoError(t, err)
+		assert.Len(t, tenants, 2)
+
+		exists, err := client.TenantExists("MultiTenantCollection", "tenant1")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = client.TenantExists("MultiTenantCollection", "missing")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		tenant, err := client.GetTenant("MultiTenantCollection", "tenant1")
+		assert.NoError(t, err)
+		assert.Equal(t, "tenant1", tenant["name"])
+		assert.Equal(t, "ACTIVE", tenant["activityStatus"])
+
+		_, err = client.GetTenant("MultiTenantCollection", "missing")
+		var notFound *weaviate.NotFoundError
+		assert.ErrorAs(t, err, &notFound)
+
 		// Update tenant status
 		err = client.UpdateTenant("MultiTenantCollection", []map[string]interface{}{
 			{
@@ -42,11 +66,142 @@ func TestTenantManagement(t *testing.T) {
 		})
 		assert.NoError(t, err)
 
+		// Legacy statuses are reported with their current names
+		tenants, err = client.GetTenants("MultiTenantCollection")
+		assert.NoError(t, err)
+		for _, tenant := range tenants {
+			if tenant["name"] == "tenant1" {
+				assert.Equal(t, "INACTIVE", tenant["activityStatus"])
+			}
+		}
+
+		waited, err := client.WaitForTenantStatus("MultiTenantCollection", "tenant1", "inactive", 5000)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, waited, int64(0))
+
+		err = client.UpdateTenant("MultiTenantCollection", []map[string]interface{}{
+			{"name": "tenant1", "activityStatus": "HOT"},
+		})
+		assert.NoError(t, err)
+		err = client.WaitForTenantActivity("MultiTenantCollection", "tenant1", "ACTIVE", 100, 5000)
+		assert.NoError(t, err)
+
+		// Typos are rejected before reaching the server
+		err = client.UpdateTenant("MultiTenantCollection", []map[string]interface{}{
+			{
+				"name":           "tenant2",
+				"activityStatus": "INAVTIVE",
+			},
+		})
+		assert.ErrorContains(t, err, "invalid tenant activityStatus")
+
 		// Delete tenants
 		err = client.DeleteTenant("MultiTenantCollection", []string{"tenant1", "tenant2"})
 		assert.NoError(t, err)
 	})
 
+	t.Run("bulk create tenants", func(t *testing.T) {
+		result, err := client.BulkCreateTenants("MultiTenantCollection", map[string]interface{}{
+			"prefix":      "bulk_",
+			"count":       250,
+			"batchSize":   100,
+			"concurrency": 2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 250, result["tenants"])
+		assert.Equal(t, 3, result["chunks"])
+		assert.Equal(t, 3, result["createdChunks"])
+		assert.Equal(t, 0, result["failedChunks"])
+
+		exists, err := client.TenantExists("MultiTenantCollection", "bulk_249")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		_, err = client.BulkCreateTenants("MultiTenantCollection", map[string]interface{}{
+			"batchSize": 100,
+		})
+		assert.ErrorContains(t, err, "tenants or count is required")
+	})
+
+	t.Run("batch create across tenants", func(t *testing.T) {
+		objects := make([]map[string]interface{}, 9)
+		for i := range objects {
+			objects[i] = map[string]interface{}{
+				"properties": map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+			}
+		}
+
+		tenants := []string{"bulk_0", "bulk_1", "bulk_2"}
+		result, err := client.BatchCreateAcrossTenants("MultiTenantCollection", objects, tenants)
+		assert.NoError(t, err)
+		assert.Len(t, result["results"], 9)
+
+		counts, _ := result["tenants"].(map[string]interface{})
+		for _, tenant := range tenants {
+			assert.Equal(t, map[string]int{"success": 3, "failed": 0}, counts[tenant])
+		}
+	})
+
+	t.Run("count objects per tenant", func(t *testing.T) {
+		result, err := client.TenantObjectCounts("MultiTenantCollection", []string{"bulk_0", "bulk_1", "missing"}, 2)
+		assert.NoError(t, err)
+
+		counts, _ := result["counts"].(map[string]int64)
+		assert.Equal(t, int64(3), counts["bulk_0"])
+		assert.Equal(t, int64(3), counts["bulk_1"])
+
+		errors, _ := result["errors"].(map[string]string)
+		assert.Contains(t, errors, "missing")
+	})
+
+	t.Run("default tenant", func(t *testing.T) {
+		client.SetTenant("bulk_3")
+		defer client.SetTenant("")
+
+		_, err := client.ObjectInsert("MultiTenantCollection", map[string]interface{}{
+			"properties": map[string]interface{}{"name": "default tenant object"},
+		})
+		assert.NoError(t, err)
+		// a per-call tenant overrides the default
+		_, err = client.ObjectInsert("MultiTenantCollection", map[string]interface{}{
+			"properties": map[string]interface{}{"name": "explicit tenant object"},
+			"tenant":     "bulk_4",
+		})
+		assert.NoError(t, err)
+
+		count, err := client.GetObjectsCount("MultiTenantCollection", "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		count, err = client.GetObjectsCount("MultiTenantCollection", "bulk_4")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("clone with overrides", func(t *testing.T) {
+		clone, err := client.Clone(map[string]interface{}{
+			"tenant":           "bulk_5",
+			"consistencyLevel": "one",
+		})
+		assert.NoError(t, err)
+
+		_, err = clone.ObjectInsert("MultiTenantCollection", map[string]interface{}{
+			"properties": map[string]interface{}{"name": "cloned client object"},
+		})
+		assert.NoError(t, err)
+		count, err := clone.GetObjectsCount("MultiTenantCollection", "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		// the base client keeps its own defaults
+		_, err = client.GetObjectsCount("MultiTenantCollection", "")
+		assert.Error(t, err)
+
+		_, err = client.Clone(map[string]interface{}{"consistencyLevel": "most"})
+		assert.ErrorContains(t, err, "invalid consistency level")
+		_, err = client.Clone(map[string]interface{}{"host": "localhost:8081"})
+		assert.ErrorContains(t, err, "unsupported clone override")
+	})
+
 	// Cleanup
 	err = client.DeleteCollection("MultiTenantCollection")
 	assert.NoError(t, err)
@@ -1,6 +1,10 @@
 package tests
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/weaviate/xk6-weaviate"
@@ -18,3 +22,57 @@ func createTestClient(t *testing.T) *weaviate.Client {
 	}
 	return client
 }
+
+// fakeServer is an httptest server standing in for Weaviate. It answers
+// GraphQL queries with graphQLResponse, records their query text and serves
+// routes for the other paths. Every other request gets an empty object.
+type fakeServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	queries []string
+}
+
+func newFakeServer(t *testing.T, graphQLResponse string, routes map[string]http.HandlerFunc) *fakeServer {
+	server := &fakeServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route, ok := routes[r.URL.Path]; ok {
+			route(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/graphql" {
+			var body struct {
+				Query string `json:"query"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			server.mu.Lock()
+			server.queries = append(server.queries, body.Query)
+			server.mu.Unlock()
+			w.Write([]byte(graphQLResponse))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// client returns a REST-only client of the server
+func (s *fakeServer) client(t *testing.T) *weaviate.Client {
+	w := &weaviate.Weaviate{}
+	client, err := w.NewClient(map[string]interface{}{"host": s.URL, "grpcDisabled": true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+// lastQuery returns the text of the last GraphQL query received
+func (s *fakeServer) lastQuery() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queries) == 0 {
+		return ""
+	}
+	return s.queries[len(s.queries)-1]
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsers(t *testing.T) {
+	var deactivateBody map[string]interface{}
+	server := newFakeServer(t, `{}`, map[string]http.HandlerFunc{
+		"/v1/users/db": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[{"userId": "load-test-user", "roles": ["viewer"], "userType": "db_user", "active": true}]`))
+		},
+		"/v1/users/db/load-test-user": func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"apikey": "created-key"}`))
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+		"/v1/users/db/load-test-user/rotate-key": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"apikey": "rotated-key"}`))
+		},
+		"/v1/users/db/load-test-user/deactivate": func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&deactivateBody)
+			w.WriteHeader(http.StatusOK)
+		},
+		"/v1/users/db/existing-user": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error": [{"message": "user existing-user already exists"}]}`))
+		},
+	})
+	client := server.client(t)
+
+	t.Run("create, rotate and delete a user", func(t *testing.T) {
+		apiKey, err := client.CreateUser("load-test-user")
+		assert.NoError(t, err)
+		assert.Equal(t, "created-key", apiKey)
+
+		apiKey, err = client.RotateUserKey("load-test-user")
+		assert.NoError(t, err)
+		assert.Equal(t, "rotated-key", apiKey)
+
+		err = client.DeleteUser("load-test-user")
+		assert.NoError(t, err)
+	})
+
+	t.Run("list users", func(t *testing.T) {
+		users, err := client.ListUsers()
+		assert.NoError(t, err)
+		if assert.Len(t, users, 1) {
+			assert.Equal(t, "load-test-user", users[0]["userId"])
+			assert.Equal(t, true, users[0]["active"])
+		}
+	})
+
+	t.Run("deactivate revoking the key", func(t *testing.T) {
+		err := client.DeactivateUser("load-test-user", true)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"revoke_key": true}, deactivateBody)
+	})
+
+	t.Run("server errors", func(t *testing.T) {
+		_, err := client.CreateUser("existing-user")
+		assert.ErrorContains(t, err, "409")
+	})
+
+	t.Run("userId is required", func(t *testing.T) {
+		_, err := client.CreateUser("")
+		assert.ErrorContains(t, err, "userId is required")
+
+		_, err = client.RotateUserKey("")
+		assert.ErrorContains(t, err, "userId is required")
+
+		err = client.DeleteUser("")
+		assert.ErrorContains(t, err, "userId is required")
+
+		err = client.ActivateUser("")
+		assert.ErrorContains(t, err, "userId is required")
+
+		err = client.DeactivateUser("", false)
+		assert.ErrorContains(t, err, "userId is required")
+	})
+}
@@ -0,0 +1,260 @@
+package weaviate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/oauth2"
+)
+
+// defaultDialTimeout matches the dial timeout of the Go default transport
+const defaultDialTimeout = 30 * time.Second
+
+// newHTTPClient builds the HTTP client shared by the go-client and the raw
+// REST connection from the connection options of a NewClient config:
+// maxConnections limits the connections per host and keeps as many idle,
+// instead of the 2 idle connections per host of the Go default
+// keepAliveSeconds is the TCP keep-alive period, keep it below the idle
+// timeout of NAT gateways (default 30)
+// http2 set to false only uses HTTP/1.1, for proxies mishandling HTTP/2
+// enableCompression requests gzip compressed responses when true (the Go
+// default) and uncompressed ones when false
+// tls sets the trust settings of https connections, see tlsConfig
+// proxy sends the requests through a proxy, see proxyConfig. Without it the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply.
+func newHTTPClient(cfg map[string]interface{}) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	proxy, err := proxyConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if proxy != nil {
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxy(req.URL)
+		}
+	}
+
+	tlsCfg, err := tlsConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsCfg != nil {
+		transport.TLSClientConfig = tlsCfg
+	}
+
+	if value, exists := cfg["maxConnections"]; exists {
+		maxConnections, ok := ToInt(value)
+		if !ok || maxConnections <= 0 {
+			return nil, fmt.Errorf("maxConnections must be a positive integer")
+		}
+		transport.MaxConnsPerHost = maxConnections
+		transport.MaxIdleConnsPerHost = maxConnections
+		if transport.MaxIdleConns < maxConnections {
+			transport.MaxIdleConns = maxConnections
+		}
+	}
+
+	if value, exists := cfg["keepAliveSeconds"]; exists {
+		seconds, ok := ToFloat64(value)
+		if !ok || seconds <= 0 {
+			return nil, fmt.Errorf("keepAliveSeconds must be a positive number")
+		}
+		dialer := &net.Dialer{
+			Timeout:   defaultDialTimeout,
+			KeepAlive: time.Duration(seconds * float64(time.Second)),
+		}
+		transport.DialContext = dialer.DialContext
+	}
+
+	if value, exists := cfg["http2"]; exists {
+		enabled, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("http2 must be a boolean")
+		}
+		if !enabled {
+			disableHTTP2(transport)
+		}
+	}
+
+	if value, exists := cfg["enableCompression"]; exists {
+		enabled, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("enableCompression must be a boolean")
+		}
+		transport.DisableCompression = !enabled
+	}
+
+	if value, exists := cfg["transport"]; exists {
+		if err := applyTransportOptions(transport, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Client{Transport: transport, Timeout: defaultRequestTimeout}, nil
+}
+
+// applyTransportOptions tunes the connection pool of transport with the
+// transport option of a NewClient config, which overrides maxConnections and
+// http2. transport is a map of:
+// maxIdleConns, the idle connections kept over all hosts (0 for no limit)
+// maxIdleConnsPerHost, the idle connections kept per host
+// maxConnsPerHost, the connections per host (0 for no limit)
+// idleConnTimeoutMs, how long an idle connection is kept (default 90000)
+// disableKeepAlives, to use every connection for a single request
+// http2, false to only use HTTP/1.1
+func applyTransportOptions(transport *http.Transport, value interface{}) error {
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("transport must be an object")
+	}
+
+	limits := map[string]*int{
+		"maxIdleConns":        &transport.MaxIdleConns,
+		"maxIdleConnsPerHost": &transport.MaxIdleConnsPerHost,
+		"maxConnsPerHost":     &transport.MaxConnsPerHost,
+	}
+	for name, limit := range limits {
+		value, exists := options[name]
+		if !exists {
+			continue
+		}
+		n, ok := ToInt(value)
+		if !ok || n < 0 {
+			return fmt.Errorf("transport.%s must be a non-negative integer", name)
+		}
+		*limit = n
+	}
+
+	if value, exists := options["idleConnTimeoutMs"]; exists {
+		ms, ok := ToFloat64(value)
+		if !ok || ms <= 0 {
+			return fmt.Errorf("transport.idleConnTimeoutMs must be a positive number")
+		}
+		transport.IdleConnTimeout = time.Duration(ms * float64(time.Millisecond))
+	}
+
+	if value, exists := options["disableKeepAlives"]; exists {
+		disabled, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("transport.disableKeepAlives must be a boolean")
+		}
+		transport.DisableKeepAlives = disabled
+	}
+
+	if value, exists := options["http2"]; exists {
+		enabled, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("transport.http2 must be a boolean")
+		}
+		if enabled {
+			transport.ForceAttemptHTTP2 = true
+			transport.TLSNextProto = nil
+		} else {
+			disableHTTP2(transport)
+		}
+	}
+	return nil
+}
+
+// disableHTTP2 makes transport only use HTTP/1.1
+func disableHTTP2(transport *http.Transport) {
+	// a non-nil empty TLSNextProto disables the HTTP/2 upgrade
+	transport.ForceAttemptHTTP2 = false
+	transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+}
+
+// proxyConfig returns the proxy selection of the proxy option of a NewClient
+// config, or nil when it is not set. proxy is a map of:
+// url, the proxy of http and https requests (e.g. http://proxy:3128)
+// noProxy, the hosts reached without the proxy, in the format of NO_PROXY
+func proxyConfig(cfg map[string]interface{}) (func(*url.URL) (*url.URL, error), error) {
+	value, exists := cfg["proxy"]
+	if !exists {
+		return nil, nil
+	}
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("proxy must be an object")
+	}
+
+	proxyURL := GetStringValue(options, "url")
+	if parsed, err := url.Parse(proxyURL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid proxy url: %s", proxyURL)
+	}
+	proxy := httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    strings.Join(GetStringSlice(options["noProxy"]), ","),
+	}
+	return proxy.ProxyFunc(), nil
+}
+
+// tlsConfig builds the TLS config of the tls option of a NewClient config,
+// or returns nil when it is not set. tls is a map of:
+// caCertPem, a PEM encoded CA bundle server certificates must be signed by
+// (default: the system roots)
+// insecureSkipVerify to accept any server certificate (default false)
+// clientCertPem and clientKeyPem, a PEM encoded client certificate and key
+// for mutual TLS
+func tlsConfig(cfg map[string]interface{}) (*tls.Config, error) {
+	value, exists := cfg["tls"]
+	if !exists {
+		return nil, nil
+	}
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("tls must be an object")
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caCert := GetStringValue(options, "caCertPem"); caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("tls.caCertPem contains no valid PEM certificates")
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if value, exists := options["insecureSkipVerify"]; exists {
+		skip, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("tls.insecureSkipVerify must be a boolean")
+		}
+		tlsCfg.InsecureSkipVerify = skip
+	}
+
+	clientCert, clientKey := GetStringValue(options, "clientCertPem"), GetStringValue(options, "clientKeyPem")
+	if (clientCert == "") != (clientKey == "") {
+		return nil, fmt.Errorf("tls.clientCertPem and tls.clientKeyPem must be set together")
+	}
+	if clientCert != "" {
+		certificate, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid tls client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsCfg, nil
+}
+
+// withTransport returns the HTTP client resolved by an auth config using the
+// transport of base. OAuth clients keep their token source and send their
+// requests through the base transport.
+func withTransport(authClient, base *http.Client) *http.Client {
+	if authClient == nil {
+		return base
+	}
+	if transport, ok := authClient.Transport.(*oauth2.Transport); ok {
+		transport.Base = base.Transport
+	}
+	authClient.Timeout = base.Timeout
+	return authClient
+}
@@ -0,0 +1,89 @@
+package weaviate
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// apiKeyResponse is returned by the db user create and key rotation requests
+type apiKeyResponse struct {
+	APIKey string `json:"apikey"`
+}
+
+// dbUserPath returns the users API path of a db user
+func dbUserPath(userID string) (string, error) {
+	if userID == "" {
+		return "", fmt.Errorf("userId is required")
+	}
+	return "/users/db/" + url.PathEscape(userID), nil
+}
+
+// CreateUser creates a db user and returns its API key, which can be passed
+// as apiKey to NewClient to send requests as that user. The key cannot be
+// retrieved again, only rotated. Requires Weaviate 1.30 with db users enabled.
+func (c *Client) CreateUser(userID string) (string, error) {
+	path, err := dbUserPath(userID)
+	if err != nil {
+		return "", err
+	}
+	var response apiKeyResponse
+	if err := c.runREST(http.MethodPost, path, nil, &response, http.StatusCreated); err != nil {
+		return "", err
+	}
+	return response.APIKey, nil
+}
+
+// RotateUserKey replaces the API key of a db user and returns the new key,
+// the previous key stops working immediately
+func (c *Client) RotateUserKey(userID string) (string, error) {
+	path, err := dbUserPath(userID)
+	if err != nil {
+		return "", err
+	}
+	var response apiKeyResponse
+	if err := c.runREST(http.MethodPost, path+"/rotate-key", nil, &response, http.StatusOK); err != nil {
+		return "", err
+	}
+	return response.APIKey, nil
+}
+
+// DeleteUser deletes a db user
+func (c *Client) DeleteUser(userID string) error {
+	path, err := dbUserPath(userID)
+	if err != nil {
+		return err
+	}
+	return c.runREST(http.MethodDelete, path, nil, nil, http.StatusNoContent)
+}
+
+// ListUsers lists the db users as reported by the server, as {userId, roles,
+// userType, active} maps
+func (c *Client) ListUsers() ([]map[string]interface{}, error) {
+	var users []map[string]interface{}
+	if err := c.runREST(http.MethodGet, "/users/db", nil, &users, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// ActivateUser reactivates a deactivated db user
+func (c *Client) ActivateUser(userID string) error {
+	path, err := dbUserPath(userID)
+	if err != nil {
+		return err
+	}
+	return c.runREST(http.MethodPost, path+"/activate", nil, nil, http.StatusOK)
+}
+
+// DeactivateUser deactivates a db user, its requests are rejected until it is
+// activated again. With revokeKey the API key is revoked too and has to be
+// rotated before the user can be used again.
+func (c *Client) DeactivateUser(userID string, revokeKey bool) error {
+	path, err := dbUserPath(userID)
+	if err != nil {
+		return err
+	}
+	body := map[string]bool{"revoke_key": revokeKey}
+	return c.runREST(http.MethodPost, path+"/deactivate", body, nil, http.StatusOK)
+}
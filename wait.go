@@ -0,0 +1,296 @@
+package weaviate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	defaultWaitTimeout      = 60 * time.Second
+	defaultWaitPollInterval = 500 * time.Millisecond
+)
+
+// waitOptions reads timeoutMs and pollIntervalMs from a JS options map
+func waitOptions(opts map[string]interface{}) (timeout, pollInterval time.Duration) {
+	timeout, pollInterval = defaultWaitTimeout, defaultWaitPollInterval
+	if ms, ok := ToInt(opts["timeoutMs"]); ok && ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+	if ms, ok := ToInt(opts["pollIntervalMs"]); ok && ms > 0 {
+		pollInterval = time.Duration(ms) * time.Millisecond
+	}
+	return timeout, pollInterval
+}
+
+// pollUntil calls check until it reports no pending items or timeout
+// elapses. The wait between two checks starts at pollInterval and doubles up
+// to maxPollInterval, with a lower maxPollInterval it stays at pollInterval.
+// The last check is made at the deadline. On timeout the error lists the
+// items still pending.
+func pollUntil(what string, timeout, pollInterval, maxPollInterval time.Duration, check func() ([]string, error)) (time.Duration, error) {
+	start := time.Now()
+	deadline := start.Add(timeout)
+	interval := pollInterval
+	for attempts := 1; ; attempts++ {
+		pending, err := check()
+		if err != nil {
+			return time.Since(start), err
+		}
+		if len(pending) == 0 {
+			return time.Since(start), nil
+		}
+		if !time.Now().Before(deadline) {
+			sort.Strings(pending)
+			return time.Since(start), fmt.Errorf("%s not ready after %s and %d attempts, pending: %s", what, timeout, attempts, strings.Join(pending, ", "))
+		}
+		time.Sleep(min(interval, time.Until(deadline)))
+		interval = min(2*interval, max(pollInterval, maxPollInterval))
+	}
+}
+
+// transitionalTenantStatuses are the statuses of tenants that are still
+// being loaded or unloaded
+var transitionalTenantStatuses = map[string]bool{
+	models.TenantActivityStatusOFFLOADING: true,
+	models.TenantActivityStatusONLOADING:  true,
+	models.TenantActivityStatusFREEZING:   true,
+	models.TenantActivityStatusUNFREEZING: true,
+}
+
+// WaitForCollectionReady waits until every shard of a collection is READY,
+// and for multi-tenant collections until no tenant is being loaded or
+// unloaded. It returns the time waited in milliseconds.
+// opts is an optional map of:
+// timeoutMs is the maximum time to wait (default 60000)
+// pollIntervalMs is the time between two checks (default 500)
+func (c *Client) WaitForCollectionReady(className string, opts map[string]interface{}) (int64, error) {
+	timeout, pollInterval := waitOptions(opts)
+
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	class, err := c.client.Schema().ClassGetter().
+		WithClassName(className).
+		Do(ctx)
+	if err != nil {
+		return 0, requestError(ctx, err)
+	}
+	multiTenant := class.MultiTenancyConfig != nil && class.MultiTenancyConfig.Enabled
+
+	waited, err := pollUntil("collection "+className, timeout, pollInterval, 0, func() ([]string, error) {
+		ctx, cancel := c.requestContext(nil)
+		defer cancel()
+		shards, err := c.client.Schema().ShardsGetter().
+			WithClassName(className).
+			Do(ctx)
+		if err != nil {
+			return nil, requestError(ctx, err)
+		}
+
+		pending := make([]string, 0)
+		for _, shard := range shards {
+			if shard.Status != "READY" {
+				pending = append(pending, fmt.Sprintf("shard %s (%s)", shard.Name, shard.Status))
+			}
+		}
+
+		if multiTenant {
+			tenants, err := c.client.Schema().TenantsGetter().
+				WithClassName(className).
+				Do(ctx)
+			if err != nil {
+				return nil, requestError(ctx, err)
+			}
+			for _, tenant := range tenants {
+				if transitionalTenantStatuses[tenant.ActivityStatus] {
+					pending = append(pending, fmt.Sprintf("tenant %s (%s)", tenant.Name, tenant.ActivityStatus))
+				}
+			}
+		}
+
+		return pending, nil
+	})
+	return waited.Milliseconds(), err
+}
+
+// WaitForVectorization waits until every shard of a collection is READY with
+// an empty vector queue, i.e. asynchronous vectorization and indexing are
+// done. pollIntervalMs and timeoutMs default to 500 and 60000.
+func (c *Client) WaitForVectorization(className string, pollIntervalMs int, timeoutMs int) error {
+	timeout, pollInterval := waitOptions(map[string]interface{}{
+		"timeoutMs":      timeoutMs,
+		"pollIntervalMs": pollIntervalMs,
+	})
+
+	_, err := pollUntil("vectorization of "+className, timeout, pollInterval, 0, func() ([]string, error) {
+		ctx, cancel := c.requestContext(nil)
+		defer cancel()
+		shards, err := c.client.Schema().ShardsGetter().
+			WithClassName(className).
+			Do(ctx)
+		if err != nil {
+			return nil, requestError(ctx, err)
+		}
+
+		pending := make([]string, 0)
+		for _, shard := range shards {
+			if shard.Status != "READY" || shard.VectorQueueSize > 0 {
+				pending = append(pending, fmt.Sprintf("shard %s (%s, %d queued)", shard.Name, shard.Status, shard.VectorQueueSize))
+			}
+		}
+		return pending, nil
+	})
+	return err
+}
+
+// GetVectorQueueSize returns the number of objects waiting to be indexed in a
+// collection, summed over the shards of all nodes. tenant optionally restricts
+// the count to the shard of one tenant.
+func (c *Client) GetVectorQueueSize(className string, tenant string) (int64, error) {
+	ctx, cancel := c.requestContext(nil)
+	defer cancel()
+	status, err := c.client.Cluster().NodesStatusGetter().
+		WithClass(className).
+		WithOutput("verbose").
+		Do(ctx)
+	if err != nil {
+		return 0, requestError(ctx, err)
+	}
+
+	var size int64
+	for _, node := range status.Nodes {
+		for _, shard := range node.Shards {
+			// the shard of a tenant is named after the tenant
+			if tenant != "" && shard.Name != tenant {
+				continue
+			}
+			size += shard.VectorQueueLength
+		}
+	}
+	return size, nil
+}
+
+// WaitForIndexing waits until the vector queue of a collection, or of one
+// tenant, is empty and returns the time it took to drain in milliseconds.
+// timeoutMs defaults to 60000.
+func (c *Client) WaitForIndexing(className string, timeoutMs int, tenant string) (int64, error) {
+	timeout := defaultWaitTimeout
+	if timeoutMs > 0 {
+		timeout = time.Duration(timeoutMs) * time.Millisecond
+	}
+
+	waited, err := pollUntil("indexing of "+className, timeout, defaultWaitPollInterval, 0, func() ([]string, error) {
+		size, err := c.GetVectorQueueSize(className, tenant)
+		if err != nil {
+			return nil, err
+		}
+		if size > 0 {
+			return []string{fmt.Sprintf("%d queued vectors", size)}, nil
+		}
+		return nil, nil
+	})
+	return waited.Milliseconds(), err
+}
+
+// WaitForTenantStatus waits until a tenant reaches the given activity status,
+// e.g. OFFLOADED after offloading it with UpdateTenant. It returns the time
+// waited in milliseconds.
+func (c *Client) WaitForTenantStatus(className string, tenantName string, status string, timeoutMs int) (int64, error) {
+	timeout, pollInterval := waitOptions(map[string]interface{}{"timeoutMs": timeoutMs})
+	waited, err := c.waitForTenant(className, tenantName, status, timeout, pollInterval)
+	return waited.Milliseconds(), err
+}
+
+// WaitForTenantActivity waits until a tenant reaches the given activity
+// status, polling every pollIntervalMs (default 500) for at most timeoutMs
+// (default 60000), e.g. until a tenant being activated is ACTIVE
+func (c *Client) WaitForTenantActivity(className string, tenantName string, expectedStatus string, pollIntervalMs int, timeoutMs int) error {
+	timeout, pollInterval := waitOptions(map[string]interface{}{
+		"timeoutMs":      timeoutMs,
+		"pollIntervalMs": pollIntervalMs,
+	})
+	_, err := c.waitForTenant(className, tenantName, expectedStatus, timeout, pollInterval)
+	return err
+}
+
+// waitForTenant polls GetTenant until the tenant has the given status
+func (c *Client) waitForTenant(className, tenantName, status string, timeout, pollInterval time.Duration) (time.Duration, error) {
+	expected, err := NormalizeTenantStatus(status)
+	if err != nil {
+		return 0, err
+	}
+
+	what := fmt.Sprintf("tenant %s of collection %s", tenantName, className)
+	return pollUntil(what, timeout, pollInterval, 0, func() ([]string, error) {
+		tenant, err := c.GetTenant(className, tenantName)
+		if err != nil {
+			return nil, err
+		}
+		if current := tenant["activityStatus"]; current != expected {
+			return []string{fmt.Sprintf("status %s, expected %s", current, expected)}, nil
+		}
+		return nil, nil
+	})
+}
+
+// IsLive reports whether Weaviate answers its liveness probe. An unreachable
+// server is not live.
+func (c *Client) IsLive() bool {
+	ctx, cancel := context.WithTimeout(c.baseContext(), defaultConnectTimeout)
+	defer cancel()
+	live, err := c.client.Misc().LiveChecker().Do(ctx)
+	return err == nil && live
+}
+
+// IsReady reports whether Weaviate answers its readiness probe. An
+// unreachable server is not ready.
+func (c *Client) IsReady() bool {
+	return c.readiness() == ""
+}
+
+// readiness returns why Weaviate is not ready, or an empty string when it is
+func (c *Client) readiness() string {
+	return readiness(c.baseContext(), c.rest)
+}
+
+// readiness returns why the Weaviate behind con is not ready, or an empty
+// string when it is
+func readiness(parent context.Context, con *connection.Connection) string {
+	ctx, cancel := context.WithTimeout(parent, defaultConnectTimeout)
+	defer cancel()
+	response, err := con.RunREST(ctx, "/.well-known/ready", http.MethodGet, nil)
+	if err != nil {
+		return requestError(ctx, err).Error()
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Sprintf("readiness probe returned status code %d", response.StatusCode)
+	}
+	return ""
+}
+
+// WaitForReady polls the readiness probe every intervalMs (default 500) until
+// Weaviate is ready or timeoutMs (default 60000) elapses, and returns the time
+// waited in milliseconds. Connection errors while polling count as not ready.
+func (c *Client) WaitForReady(timeoutMs int, intervalMs int) (int64, error) {
+	timeout, pollInterval := defaultWaitTimeout, defaultWaitPollInterval
+	if timeoutMs > 0 {
+		timeout = time.Duration(timeoutMs) * time.Millisecond
+	}
+	if intervalMs > 0 {
+		pollInterval = time.Duration(intervalMs) * time.Millisecond
+	}
+
+	waited, err := pollUntil("weaviate", timeout, pollInterval, 0, func() ([]string, error) {
+		if reason := c.readiness(); reason != "" {
+			return []string{reason}, nil
+		}
+		return nil, nil
+	})
+	return waited.Milliseconds(), err
+}